@@ -3,26 +3,80 @@ package handler
 import (
 	broad "api-gateway/internal/broadcast"
 	"api-gateway/models"
+	"api-gateway/utils/idempotency"
+	token "api-gateway/utils/jwt"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Handler struct {
 	B *broad.Adjust
 }
 
+// authorize lets the owner of a record and admins through and answers 403
+// to everyone else.
+func authorize(w http.ResponseWriter, r *http.Request, ownerID int32) bool {
+	if token.FromContext(r.Context()).CanAccess(ownerID) {
+		return true
+	}
+	http.Error(w, models.ErrForbidden.Error(), http.StatusForbidden)
+	return false
+}
+
+// caller is the id of the user who made r, zero without a token.
+func caller(r *http.Request) int32 {
+	if claims := token.FromContext(r.Context()); claims != nil {
+		return claims.UserID
+	}
+	return 0
+}
+
+// commandKey is the Idempotency-Key of r scoped to the caller.
+func commandKey(r *http.Request) string {
+	return idempotency.Key(r, strconv.Itoa(int(caller(r))))
+}
+
+// authorizeBooking looks up the owner of a booking, see authorize.
+func (u *Handler) authorizeBooking(w http.ResponseWriter, r *http.Request, id int32) bool {
+	res, err := u.B.GetBooking(&models.GetUsersBookRequest{ID: id})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	return authorize(w, r, res.UserID)
+}
+
+// authorizeWaiting looks up the owner of a waiting list entry, see authorize.
+func (u *Handler) authorizeWaiting(w http.ResponseWriter, r *http.Request, id int32) (*models.GetWaitinglistResponse, bool) {
+	res, err := u.B.GetWaiting(&models.GetWaitinglistRequest{ID: id})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return res, authorize(w, r, res.UserID)
+}
+
 // Register handles the user registration process.
 // @Summary Register a new user
-// @Description Register a new user by providing email and password. A verification code will be sent to the provided email.
+// @Description Register a new user by providing email and password. A verification code will be sent to the provided email; the signup waits 10 minutes for it. The password needs at least 8 characters.
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param registerUserRequest body models.RegisterUserRequest true "User registration data"
 // @Success 200 {string} string "Verification code is sent to your email"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 409 {string} string "A signup of this email is waiting for its code"
+// @Failure 429 {string} string "Too Many Requests"
 // @Failure 500 {string} string "Internal Server Error"
-// @Failure 403 {string} string "Already Exists"
 // @Router /users/register [post]
 func (u *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -30,11 +84,38 @@ func (u *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	var req models.RegisterUserRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	if err := u.B.Register(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		signupError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode("Verification code is sent to your email")
+}
+
+// ResendCode godoc
+// @Summary      Resend the verification code
+// @Description  Email a new code for a signup waiting for one, at most once a minute and 5 times. The earlier code stops working and the signup waits 10 more minutes.
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        resend  body      models.ResendCodeRequest  true  "Email of the signup"
+// @Success      200     {string}  string  "Verification code is sent to your email"
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      404     {string}  string  "Not Found"
+// @Failure      429     {string}  string  "Too Many Requests"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Router       /users/verify/resend [post]
+func (u *Handler) ResendCode(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var req models.ResendCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := u.B.ResendCode(&req); err != nil {
+		signupError(w, err)
 		return
 	}
 	json.NewEncoder(w).Encode("Verification code is sent to your email")
@@ -42,12 +123,15 @@ func (u *Handler) Register(w http.ResponseWriter, r *http.Request) {
 
 // Verify handles the user verification process.
 // @Summary Verify a user account
-// @Description Verify a user account by providing the verification code sent to the user's email.
+// @Description Verify a user account by providing the verification code sent to the user's email. After 5 wrong codes the signup is dropped and the email can't sign up for 15 minutes.
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param verifyRequest body models.VerifyRequest true "Verification code data"
-// @Success 200 {string} string "You have verified your account and now you can log in"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 429 {string} string "Too Many Requests"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /users/verify [post]
 func (u *Handler) Verify(w http.ResponseWriter, r *http.Request) {
@@ -56,25 +140,44 @@ func (u *Handler) Verify(w http.ResponseWriter, r *http.Request) {
 	var req models.VerifyRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if err := u.B.Verify(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	id, err := u.B.Verify(&req, idempotency.Key(r, "signup:"+req.Email))
+	if err != nil {
+		signupError(w, err)
 		return
 	}
-	json.NewEncoder(w).Encode("You have verified your account and now you can log in")
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "You have verified your account, it is being created and then you can log in", CommandID: id})
+}
+
+func signupError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, models.ErrWeakPassword), errors.Is(err, models.ErrInvalidCode):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, models.ErrSignupNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, models.ErrSignupPending):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, models.ErrTooManyAttempts), errors.Is(err, models.ErrCodeCooldown):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
 }
 
 // LogIn handles the user login process.
 // @Summary Log in a user
-// @Description Log in a user by providing their email and password.
+// @Description Log in a user by providing their email and password. Failed logins make the next tries to the account, or from the address, wait longer each time; 10 in 15 minutes lock the account for 30 minutes and email its owner.
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param logInRequest body models.LogInRequest true "User login data"
-// @Success 200 {string} string "JWT token"
+// @Success 200 {object} models.TokenResponse "Access token valid for expires_in seconds and a refresh token"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 423 {string} string "Locked"
+// @Failure 429 {string} string "Too Many Requests, see Retry-After"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /users/login [post]
 func (u *Handler) LogIn(w http.ResponseWriter, r *http.Request) {
@@ -87,12 +190,164 @@ func (u *Handler) LogIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := u.B.Login(&req)
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	res, err := u.B.Login(&req, ip)
+	if err != nil {
+		var retry *models.RetryError
+		switch {
+		case errors.As(err, &retry):
+			w.Header().Set("Retry-After", strconv.Itoa(int(retry.After.Seconds())+1))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+		case errors.Is(err, models.ErrBadCredentials):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, models.ErrAccountLocked):
+			http.Error(w, err.Error(), http.StatusLocked)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// Refresh godoc
+// @Summary      Refresh the tokens
+// @Description  Trade a refresh token for a new access token and a new refresh token. A refresh token works once; using it again ends its session.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        refresh  body      models.RefreshRequest  true  "Refresh token from login or the last refresh"
+// @Success      200      {object}  models.TokenResponse
+// @Failure      400      {string}  string  "Bad Request"
+// @Failure      401      {string}  string  "Unauthorized"
+// @Failure      500      {string}  string  "Internal Server Error"
+// @Router       /auth/refresh [post]
+func (u *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var req models.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.RefreshToken == "" {
+		http.Error(w, "refresh_token is required", http.StatusBadRequest)
+		return
+	}
+	res, err := u.B.Refresh(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRefresh) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// JWKS godoc
+// @Summary      Token signing keys
+// @Description  Public keys access tokens are signed with, as a JSON Web Key Set. Services verify a token with the key of its kid header; retired keys stay listed until their tokens expire.
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  jwttoken.JWKS
+// @Router       /.well-known/jwks.json [get]
+func (u *Handler) JWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(token.PublicKeys())
+}
+
+// LogOutAll godoc
+// @Summary      Log out everywhere
+// @Description  End every session of the caller: all their access and refresh tokens stop working.
+// @Tags         auth
+// @Produce      json
+// @Param        Idempotency-Key  header    string  false  "Key that makes retries of this request safe"
+// @Success      200              {object}  models.GeneralResponse
+// @Failure      401              {string}  string  "Unauthorized"
+// @Failure      500              {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /auth/logout-all [post]
+func (u *Handler) LogOutAll(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	claims := token.FromContext(r.Context())
+	command, err := u.B.LogoutAll(&models.GetUserRequest{ID: claims.UserID}, commandKey(r))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode(token)
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "You have logged out on all devices!", CommandID: command})
+}
+
+// ForgotPassword godoc
+// @Summary      Ask for a password reset code
+// @Description  Email a one-time code to reset the password of the account. The code expires in 15 minutes and a new one can be asked for once a minute. The answer is the same whether or not the email has an account.
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        forgot  body      models.ForgotPasswordRequest  true  "Email of the account"
+// @Success      200     {object}  models.GeneralResponse
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      429     {string}  string  "Too Many Requests"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Router       /users/password/forgot [post]
+func (u *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var req models.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Email == "" {
+		http.Error(w, "email is required", http.StatusBadRequest)
+		return
+	}
+	if err := u.B.ForgotPassword(&req); err != nil {
+		if errors.Is(err, models.ErrCodeCooldown) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "If the email has an account, a reset code has been sent to it"})
+}
+
+// ResetPassword godoc
+// @Summary      Reset the password
+// @Description  Set a new password with the code from POST /users/password/forgot. A code works once and only 5 tries are allowed. All sessions of the account are ended.
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        reset  body      models.ResetPasswordRequest  true  "Email, code and the new password"
+// @Success      200    {object}  models.GeneralResponse
+// @Failure      400    {string}  string  "Bad Request"
+// @Failure      429    {string}  string  "Too Many Requests"
+// @Failure      500    {string}  string  "Internal Server Error"
+// @Router       /users/password/reset [post]
+func (u *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var req models.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := u.B.ResetPassword(&req); err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidResetCode), errors.Is(err, models.ErrWeakPassword):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, models.ErrTooManyAttempts):
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "Your password has been reset, log in with the new one"})
 }
 
 // GetUser retrieves user information by ID.
@@ -103,6 +358,7 @@ func (u *Handler) LogIn(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path int true "User ID"
 // @Success 200 {object} models.GetUserResponse "User information"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /users/{id} [get]
@@ -113,6 +369,9 @@ func (u *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if !authorize(w, r, int32(id)) {
+		return
+	}
 	res, err := u.B.GetUser(&models.GetUserRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -129,7 +388,9 @@ func (u *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path int true "User ID"
 // @Param updateUserRequest body models.UpdateUserRequest true "User update data"
-// @Success 200 {string} string "Your account is updating we'll notify you when it's updated"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /users/{id} [put]
@@ -140,6 +401,9 @@ func (u *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if !authorize(w, r, int32(id)) {
+		return
+	}
 	var req models.UpdateUserRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -147,11 +411,12 @@ func (u *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req.ID = int32(id)
-	if err := u.B.UpdateUser(&req); err != nil {
+	command, err := u.B.UpdateUser(&req, caller(r), commandKey(r))
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode("Your account is updating we'll notify you when it's updated")
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "Your account is updating we'll notify you when it's updated", CommandID: command})
 }
 
 // DeleteUser deletes a user by ID.
@@ -161,7 +426,9 @@ func (u *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
-// @Success 200 {string} string "Your account is deleting"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /users/{id} [delete]
@@ -172,21 +439,27 @@ func (u *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if err := u.B.DeleteUser(&models.GetUserRequest{ID: int32(id)}); err != nil {
+	if !authorize(w, r, int32(id)) {
+		return
+	}
+	command, err := u.B.DeleteUser(&models.GetUserRequest{ID: int32(id)}, caller(r), commandKey(r))
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode("Your account is deleting")
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "Your account is deleting", CommandID: command})
 }
 
 // LogOut logs out a user by ID.
 // @Summary Log out a user
-// @Description Log out a user by providing their user ID.
+// @Description Log out a user by providing their user ID. Their own call ends the session of the token used; an admin logging out someone else ends all of that user's sessions.
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
-// @Success 200 {string} string "You have logged out!"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /users/logout/{id} [post]
@@ -197,11 +470,114 @@ func (u *Handler) LogOut(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if err := u.B.Logout(&models.GetUserRequest{ID: int32(id)}); err != nil {
+	if !authorize(w, r, int32(id)) {
+		return
+	}
+	command, err := u.B.Logout(&models.GetUserRequest{ID: int32(id)}, token.FromContext(r.Context()), commandKey(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: "You have logged out!", CommandID: command})
+}
+
+// UnlockAccount godoc
+// @Summary      Unlock an account
+// @Description  Lift the lock of an account after failed logins and forget its failed logins. Admins only.
+// @Tags         user
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  models.AccountLock
+// @Failure      400  {string}  string  "Bad Request"
+// @Failure      403  {string}  string  "Forbidden"
+// @Failure      404  {string}  string  "Not Found"
+// @Failure      500  {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /users/unlock/{id} [post]
+func (u *Handler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := u.B.UnlockAccount(&models.GetUserRequest{ID: int32(id)})
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// GrantRole godoc
+// @Summary      Grant a role
+// @Description  Give a user the guest, manager or admin role. A manager is assigned the hotel in hotel_id, call again for more hotels. The user's sessions are ended, their next login carries the change. Admins only.
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                 true  "User ID"
+// @Param        role  body      models.RoleRequest  true  "Role and, for a manager, the hotel"
+// @Success      200   {object}  models.UserRoles
+// @Failure      400   {string}  string  "Bad Request"
+// @Failure      403   {string}  string  "Forbidden"
+// @Failure      404   {string}  string  "Not Found"
+// @Failure      500   {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /users/roles/{id} [post]
+func (u *Handler) GrantRole(w http.ResponseWriter, r *http.Request) {
+	u.changeRole(w, r, u.B.GrantRole)
+}
+
+// RevokeRole godoc
+// @Summary      Revoke a role
+// @Description  Take a role away from a user. With hotel_id a manager only loses that hotel; without it they lose the role and all their hotels. The user's sessions are ended. Admins only.
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                 true  "User ID"
+// @Param        role  body      models.RoleRequest  true  "Role and, for a manager, the hotel"
+// @Success      200   {object}  models.UserRoles
+// @Failure      400   {string}  string  "Bad Request"
+// @Failure      403   {string}  string  "Forbidden"
+// @Failure      404   {string}  string  "Not Found"
+// @Failure      500   {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /users/roles/{id} [delete]
+func (u *Handler) RevokeRole(w http.ResponseWriter, r *http.Request) {
+	u.changeRole(w, r, u.B.RevokeRole)
+}
+
+func (u *Handler) changeRole(w http.ResponseWriter, r *http.Request, change func(*models.RoleRequest) (*models.UserRoles, error)) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.RoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserID = int32(id)
+	res, err := change(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRole) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode("You have logged out!")
+	json.NewEncoder(w).Encode(res)
 }
 
 // Hotel---Service
@@ -214,6 +590,7 @@ func (u *Handler) LogOut(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        hotel  body      models.CreateHotelRequest  true  "Hotel details"
 // @Success      200    {string}  string                     "Hotel created successfully"
+// @Failure      403    {string}  string                     "Forbidden"
 // @Failure      500    {string}  string                     "Internal Server Error"
 // @Security     BearerAuth
 // @Router       /hotels/create [post]
@@ -279,6 +656,180 @@ func (u *Handler) GetHotels(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+// NearbyHotels godoc
+// @Summary      Find hotels near a point
+// @Description  List hotels within radius_km of the point, nearest first, with their distance
+// @Tags         hotels
+// @Accept       json
+// @Produce      json
+// @Param        lat        query     number  true   "Latitude of the point"
+// @Param        lng        query     number  true   "Longitude of the point"
+// @Param        radius_km  query     number  true   "Search radius in kilometres, at most 500"
+// @Param        limit      query     int     false  "Hotels to return, 20 by default, at most 100"
+// @Success      200        {object}  models.NearbyResponse
+// @Failure      400        {string}  string  "Bad Request"
+// @Failure      500        {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/nearby [get]
+func (u *Handler) NearbyHotels(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	q := r.URL.Query()
+	var req models.NearbyRequest
+
+	var err error
+	for name, v := range map[string]*float64{"lat": &req.Latitude, "lng": &req.Longitude, "radius_km": &req.RadiusKm} {
+		if *v, err = strconv.ParseFloat(q.Get(name), 64); err != nil {
+			http.Error(w, fmt.Sprintf("%s: %v", name, err), http.StatusBadRequest)
+			return
+		}
+	}
+	if req.Limit, err = intParam(q.Get("limit")); err != nil {
+		http.Error(w, fmt.Sprintf("limit: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	res, err := u.B.NearbyHotels(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidSearch) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// SetHotelAmenities godoc
+// @Summary      Set hotel amenities
+// @Description  Replace the amenities of a hotel with the given hotel-scope amenity codes; an empty list removes them all
+// @Tags         amenities
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                         true  "Hotel ID"
+// @Param        amenities  body      models.SetAmenitiesRequest  true  "Amenity codes, hotel_id is taken from the path"
+// @Success      200        {object}  models.GeneralResponse
+// @Failure      400        {string}  string  "Bad Request"
+// @Failure      403        {string}  string  "Forbidden"
+// @Failure      500        {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/amenities/{id} [put]
+func (u *Handler) SetHotelAmenities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.SetAmenitiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.HotelID = int32(id)
+	u.setAmenities(w, u.B.SetHotelAmenities(&req), "Hotel amenities are saved")
+}
+
+// SetRoomAmenities godoc
+// @Summary      Set room amenities
+// @Description  Replace the amenities of a room with the given room-scope amenity codes; an empty list removes them all
+// @Tags         amenities
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                         true  "Room ID"
+// @Param        amenities  body      models.SetAmenitiesRequest  true  "Hotel of the room and amenity codes"
+// @Success      200        {object}  models.GeneralResponse
+// @Failure      400        {string}  string  "Bad Request"
+// @Failure      403        {string}  string  "Forbidden"
+// @Failure      500        {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rooms/amenities/{id} [put]
+func (u *Handler) SetRoomAmenities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.SetAmenitiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.RoomID = int32(id)
+	u.setAmenities(w, u.B.SetRoomAmenities(&req), "Room amenities are saved")
+}
+
+func (u *Handler) setAmenities(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, models.ErrInvalidAmenity) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(models.GeneralResponse{Message: message})
+}
+
+// CreateAmenity godoc
+// @Summary      Add an amenity to the catalog
+// @Description  Add an amenity that hotels ("hotel" scope) or rooms ("room" scope) can be given
+// @Tags         amenities
+// @Accept       json
+// @Produce      json
+// @Param        amenity  body      models.Amenity  true  "Amenity code, name and scope"
+// @Success      200      {object}  models.GeneralResponse
+// @Failure      400      {string}  string  "Bad Request"
+// @Failure      403      {string}  string  "Forbidden"
+// @Failure      500      {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /amenities [post]
+func (u *Handler) CreateAmenity(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var req models.Amenity
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := u.B.CreateAmenity(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// ListAmenities godoc
+// @Summary      List the amenity catalog
+// @Description  List the amenities hotels and rooms can be given and searched by
+// @Tags         amenities
+// @Accept       json
+// @Produce      json
+// @Param        scope  query     string  false  "Only hotel or room amenities"
+// @Success      200    {array}   models.Amenity
+// @Failure      400    {string}  string  "Bad Request"
+// @Failure      500    {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /amenities [get]
+func (u *Handler) ListAmenities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	res, err := u.B.ListAmenities(r.URL.Query().Get("scope"))
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
 // UpdateHotel godoc
 // @Summary      Update hotel details
 // @Description  Update the details of a specific hotel by ID
@@ -288,6 +839,7 @@ func (u *Handler) GetHotels(w http.ResponseWriter, r *http.Request) {
 // @Param        id     path      int                        true  "Hotel ID"
 // @Param        hotel  body      models.UpdateHotelRequest  true  "Updated hotel details"
 // @Success      200    {string}  string                     "Hotel details are updated"
+// @Failure      403    {string}  string                     "Forbidden"
 // @Failure      500    {string}  string                     "Internal Server Error"
 // @Security     BearerAuth
 // @Router       /hotels/{id} [put]
@@ -319,6 +871,7 @@ func (u *Handler) UpdateHotel(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        id    path      int                     true  "Hotel ID"
 // @Success      200   {string}  string                  "Hotel Deleted"
+// @Failure      403   {string}  string                  "Forbidden"
 // @Failure      500   {string}  string                  "Internal Server Error"
 // @Security     BearerAuth
 // @Router       /hotels/{id} [delete]
@@ -336,58 +889,333 @@ func (u *Handler) DeleteHotel(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode("Hotel Deleted")
 }
 
-// CreateRoom godoc
-// @Summary      Create a new room
-// @Description  Create a new room in a specific hotel
-// @Tags         rooms
+// SetCancellationPolicy godoc
+// @Summary      Set hotel cancellation policy
+// @Description  Set how many hours before check-in a booking can be cancelled for free and how many nights are charged after that
+// @Tags         hotels
 // @Accept       json
 // @Produce      json
-// @Param        room   body      models.CreateRoomRequest  true  "Room details"
-// @Success      200    {string}  string                    "Room created in hotel"
-// @Failure      500    {string}  string                    "Internal Server Error"
+// @Param        id      path      int                        true  "Hotel ID"
+// @Param        policy  body      models.CancellationPolicy  true  "Cancellation policy"
+// @Success      200     {string}  string                     "Cancellation policy is saved"
+// @Failure      403     {string}  string                     "Forbidden"
+// @Failure      500     {string}  string                     "Internal Server Error"
 // @Security     BearerAuth
-// @Router       /hotels/rooms/create [post]
-func (u *Handler) CreateRoom(w http.ResponseWriter, r *http.Request) {
+// @Router       /hotels/cancellation-policy/{id} [put]
+func (u *Handler) SetCancellationPolicy(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-
-	var req models.CreateRoomRequest
-
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var req models.CancellationPolicy
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if err := u.B.CreateRoom(&req); err != nil {
+	req.HotelID = int32(id)
+	if err := u.B.SetCancellationPolicy(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode("room created in hotel")
+	json.NewEncoder(w).Encode("Cancellation policy is saved")
 }
 
-// GetRoom godoc
-// @Summary      Get room details
-// @Description  Get details of a specific room by hotel and room IDs
-// @Tags         rooms
+// GetCancellationPolicy godoc
+// @Summary      Get hotel cancellation policy
+// @Description  Get the cancellation policy of a hotel. Hotels without one allow free cancellation until check-in.
+// @Tags         hotels
 // @Accept       json
 // @Produce      json
-// @Param        hotel  query     int                     true  "Hotel ID"
-// @Param        room   query     int                     true  "Room ID"
-// @Success      200    {object}  models.GetRoomResponse
-// @Failure      500    {string}  string                  "Internal Server Error"
+// @Param        id   path      int  true  "Hotel ID"
+// @Success      200  {object}  models.CancellationPolicy
+// @Failure      500  {string}  string  "Internal Server Error"
 // @Security     BearerAuth
-// @Router       /hotels/room [get]
-func (u *Handler) GetRoom(w http.ResponseWriter, r *http.Request) {
+// @Router       /hotels/cancellation-policy/{id} [get]
+func (u *Handler) GetCancellationPolicy(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	roomid, err := strconv.Atoi(r.URL.Query().Get("room"))
+	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	hotelid, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	res, err := u.B.GetCancellationPolicy(&models.GetHotelRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
+	json.NewEncoder(w).Encode(res)
+}
+
+// CreateRate godoc
+// @Summary      Add a hotel rate
+// @Description  Add a rate that overrides room prices for a date range, with separate weekday and Friday/Saturday night prices. An empty room_type applies to every room type.
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int          true  "Hotel ID"
+// @Param        rate  body      models.Rate  true  "Rate"
+// @Success      200   {object}  models.GeneralResponse
+// @Failure      403   {string}  string  "Forbidden"
+// @Failure      500   {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rates/{id} [post]
+func (u *Handler) CreateRate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var req models.Rate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.HotelID = int32(id)
+	res, err := u.B.CreateRate(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// GetRatePlan godoc
+// @Summary      Get hotel rates
+// @Description  List the rates and length-of-stay discounts of a hotel
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int     true   "Hotel ID"
+// @Param        room_type  query     string  false  "Room type"
+// @Success      200        {object}  models.RatePlan
+// @Failure      500        {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rates/{id} [get]
+func (u *Handler) GetRatePlan(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res, err := u.B.GetRatePlan(&models.RatePlanRequest{HotelID: int32(id), RoomType: r.URL.Query().Get("room_type")})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// DeleteRate godoc
+// @Summary      Delete a hotel rate
+// @Description  Delete a rate of a hotel. Existing bookings keep the prices they were booked with.
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Hotel ID"
+// @Param        rate_id  path      int  true  "Rate ID"
+// @Success      200      {string}  string  "Rate Deleted"
+// @Failure      403      {string}  string  "Forbidden"
+// @Failure      500      {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rates/{id}/{rate_id} [delete]
+func (u *Handler) DeleteRate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rateid, err := strconv.Atoi(r.PathValue("rate_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := u.B.DeleteRate(&models.DeleteRateRequest{HotelID: int32(id), ID: int32(rateid)}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Rate Deleted")
+}
+
+// CreateStayDiscount godoc
+// @Summary      Add a length-of-stay discount
+// @Description  Take a percentage off stays of at least min_nights nights. Saving the same hotel, room type and min_nights again replaces the percentage.
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                  true  "Hotel ID"
+// @Param        discount  body      models.StayDiscount  true  "Stay discount"
+// @Success      200       {object}  models.GeneralResponse
+// @Failure      403       {string}  string  "Forbidden"
+// @Failure      500       {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/stay-discounts/{id} [post]
+func (u *Handler) CreateStayDiscount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var req models.StayDiscount
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.HotelID = int32(id)
+	res, err := u.B.CreateStayDiscount(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// DeleteStayDiscount godoc
+// @Summary      Delete a length-of-stay discount
+// @Description  Delete a length-of-stay discount of a hotel
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id           path      int  true  "Hotel ID"
+// @Param        discount_id  path      int  true  "Discount ID"
+// @Success      200          {string}  string  "Stay discount Deleted"
+// @Failure      403          {string}  string  "Forbidden"
+// @Failure      500          {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/stay-discounts/{id}/{discount_id} [delete]
+func (u *Handler) DeleteStayDiscount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	discountid, err := strconv.Atoi(r.PathValue("discount_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := u.B.DeleteStayDiscount(&models.DeleteRateRequest{HotelID: int32(id), ID: int32(discountid)}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Stay discount Deleted")
+}
+
+// Quote godoc
+// @Summary      Price a stay
+// @Description  Price a stay in a room night by night with the hotel's current rates and length-of-stay discounts
+// @Tags         rates
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int     true  "Hotel ID"
+// @Param        room_id    query     int     true  "Room ID"
+// @Param        check_in   query     string  true  "Check-in date (YYYY-MM-DD)"
+// @Param        check_out  query     string  true  "Check-out date (YYYY-MM-DD)"
+// @Success      200        {object}  models.Quote
+// @Failure      400        {string}  string  "Bad Request"
+// @Failure      500        {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/quote/{id} [get]
+func (u *Handler) Quote(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	roomid, err := strconv.Atoi(r.URL.Query().Get("room_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	checkIn, err := time.Parse(time.DateOnly, r.URL.Query().Get("check_in"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	checkOut, err := time.Parse(time.DateOnly, r.URL.Query().Get("check_out"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := u.B.Quote(&models.QuoteRequest{
+		HotelID:      int32(id),
+		RoomID:       int32(roomid),
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// CreateRoom godoc
+// @Summary      Create a new room
+// @Description  Create a new room in a specific hotel
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        room   body      models.CreateRoomRequest  true  "Room details"
+// @Success      200    {string}  string                    "Room created in hotel"
+// @Failure      400    {string}  string                    "Bad Request"
+// @Failure      403    {string}  string                    "Forbidden"
+// @Failure      500    {string}  string                    "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rooms/create [post]
+func (u *Handler) CreateRoom(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req models.CreateRoomRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := u.B.CreateRoom(&req); err != nil {
+		if errors.Is(err, models.ErrInvalidRoom) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("room created in hotel")
+}
+
+// GetRoom godoc
+// @Summary      Get room details
+// @Description  Get details of a specific room by hotel and room IDs
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        hotel  query     int                     true  "Hotel ID"
+// @Param        room   query     int                     true  "Room ID"
+// @Success      200    {object}  models.GetRoomResponse
+// @Failure      500    {string}  string                  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/room [get]
+func (u *Handler) GetRoom(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	roomid, err := strconv.Atoi(r.URL.Query().Get("room"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hotelid, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	res, err := u.B.GetRoom(&models.GetRoomRequest{HotelID: int32(hotelid), ID: int32(roomid)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -414,116 +1242,823 @@ func (u *Handler) GetRooms(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	res, err := u.B.GetRooms(&models.GetRoomRequest{HotelID: int32(id)})
+	res, err := u.B.GetRooms(&models.GetRoomRequest{HotelID: int32(id)})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// UpdateRoom godoc
+// @Summary      Update room details
+// @Description  Update the details of a specific room
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        room   body      models.UpdateRoomRequest  true  "Updated room details"
+// @Success      200    {string}  string                    "Room details are updated"
+// @Failure      400    {string}  string                    "Bad Request"
+// @Failure      403    {string}  string                    "Forbidden"
+// @Failure      500    {string}  string                    "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rooms/{id} [put]
+func (u *Handler) UpdateRoom(w http.ResponseWriter, r *http.Request) {
+	var req models.UpdateRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := u.B.UpdateRoom(&req); err != nil {
+		if errors.Is(err, models.ErrInvalidRoom) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Room details are updated")
+}
+
+// DeleteRoom godoc
+// @Summary      Delete a room
+// @Description  Delete a specific room by hotel and room IDs
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        hotel  query     int                     true  "Hotel ID"
+// @Param        room   query     int                     true  "Room ID"
+// @Success      200    {string}  string                  "Room is deleted"
+// @Failure      403    {string}  string                  "Forbidden"
+// @Failure      500    {string}  string                  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/rooms/{id} [delete]
+func (u *Handler) DeleteRoom(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	roomid, err := strconv.Atoi(r.URL.Query().Get("room"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hotelid, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := u.B.DeleteRoom(&models.GetRoomRequest{HotelID: int32(hotelid), ID: int32(roomid)}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Room is deleted")
+}
+
+// CreateRoomType godoc
+// @Summary      Add a room type
+// @Description  Add a room type to a hotel. Rooms, rates and bookings refer to it by name, which can't be changed later.
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int              true  "Hotel ID"
+// @Param        type  body      models.RoomType  true  "Room type, hotel_id is taken from the path"
+// @Success      200   {object}  models.GeneralResponse
+// @Failure      400   {string}  string  "Bad Request"
+// @Failure      403   {string}  string  "Forbidden"
+// @Failure      500   {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/room-types/{id} [post]
+func (u *Handler) CreateRoomType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.RoomType
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.HotelID = int32(id)
+	res, err := u.B.CreateRoomType(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// GetRoomTypes godoc
+// @Summary      List room types
+// @Description  List the room types of a hotel
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Hotel ID"
+// @Success      200  {array}   models.RoomType
+// @Failure      500  {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/room-types/{id} [get]
+func (u *Handler) GetRoomTypes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := u.B.GetRoomTypes(int32(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// UpdateRoomType godoc
+// @Summary      Update a room type
+// @Description  Change the capacity, beds, base price or description of a room type. The name can't be changed.
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int              true  "Hotel ID"
+// @Param        type_id  path      int              true  "Room type ID"
+// @Param        type     body      models.RoomType  true  "Room type"
+// @Success      200      {string}  string  "Room type is updated"
+// @Failure      400      {string}  string  "Bad Request"
+// @Failure      403      {string}  string  "Forbidden"
+// @Failure      500      {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/room-types/{id}/{type_id} [put]
+func (u *Handler) UpdateRoomType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	typeid, err := strconv.Atoi(r.PathValue("type_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.RoomType
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.HotelID, req.ID = int32(id), int32(typeid)
+	if err := u.B.UpdateRoomType(&req); err != nil {
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Room type is updated")
+}
+
+// DeleteRoomType godoc
+// @Summary      Delete a room type
+// @Description  Delete a room type that no room of the hotel uses anymore
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Hotel ID"
+// @Param        type_id  path      int  true  "Room type ID"
+// @Success      200      {string}  string  "Room type is deleted"
+// @Failure      400      {string}  string  "Bad Request"
+// @Failure      403      {string}  string  "Forbidden"
+// @Failure      500      {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/room-types/{id}/{type_id} [delete]
+func (u *Handler) DeleteRoomType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	typeid, err := strconv.Atoi(r.PathValue("type_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := u.B.DeleteRoomType(int32(id), int32(typeid)); err != nil {
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode("Room type is deleted")
+}
+
+// GetOccupancyCalendar godoc
+// @Summary      Get the occupancy calendar
+// @Description  Tape chart of a hotel: every room as a row, every date of [from, to) as a column, with the booking occupying the room that night. At most 92 days. Bookings still waiting for a room are only listed in bookings. With format=csv, the grid is returned as CSV with the booking IDs in the cells.
+// @Tags         rooms
+// @Accept       json
+// @Produce      json
+// @Produce      text/csv
+// @Param        id      path      int     true   "Hotel ID"
+// @Param        from    query     string  true   "First date, YYYY-MM-DD"
+// @Param        to      query     string  true   "Date after the last one, YYYY-MM-DD"
+// @Param        format  query     string  false  "json (default) or csv"
+// @Success      200     {object}  models.OccupancyCalendar
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      404     {string}  string  "Not Found"
+// @Failure      403     {string}  string  "Forbidden"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/calendar/{id} [get]
+func (u *Handler) GetOccupancyCalendar(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	from, err := time.Parse(time.DateOnly, r.URL.Query().Get("from"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	to, err := time.Parse(time.DateOnly, r.URL.Query().Get("to"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "format must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	res, err := u.B.GetOccupancyCalendar(int32(id), from, to)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRange) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, models.ErrHotelNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=calendar-%d-%s.csv", id, from.Format(time.DateOnly)))
+		if err := writeCalendar(w, res); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// writeCalendar writes the calendar grid as CSV: a row per room, a column
+// per date, the booking ID in occupied cells.
+func writeCalendar(w io.Writer, c *models.OccupancyCalendar) error {
+	out := csv.NewWriter(w)
+	header := []string{"room_id", "room_number", "room_type", "floor"}
+	for _, v := range c.Dates {
+		header = append(header, v.Format(time.DateOnly))
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, room := range c.Rooms {
+		row := []string{strconv.Itoa(int(room.RoomID)), room.RoomNumber, room.RoomType, strconv.Itoa(int(room.Floor))}
+		for _, id := range room.BookingIDs {
+			cell := ""
+			if id != 0 {
+				cell = strconv.Itoa(int(id))
+			}
+			row = append(row, cell)
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// GetReport godoc
+// @Summary      Get occupancy and revenue figures
+// @Description  Occupancy rate, ADR, RevPAR, cancellation rate and average lead time of a hotel over [from, to), a row per day, week (from Monday) or month and, with by_room_type, per room type. Revenue spreads each booking's total over its nights. With format=csv the rows are returned as CSV.
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Produce      text/csv
+// @Param        id            path      int     true   "Hotel ID"
+// @Param        from          query     string  true   "First date, YYYY-MM-DD"
+// @Param        to            query     string  true   "Date after the last one, YYYY-MM-DD"
+// @Param        period        query     string  false  "day (default), week or month"
+// @Param        by_room_type  query     bool    false  "A row per room type"
+// @Param        format        query     string  false  "json (default) or csv"
+// @Success      200           {object}  models.Report
+// @Failure      400           {string}  string  "Bad Request"
+// @Failure      404           {string}  string  "Not Found"
+// @Failure      403           {string}  string  "Forbidden"
+// @Failure      500           {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/reports/{id} [get]
+func (u *Handler) GetReport(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	q := r.URL.Query()
+	req := models.ReportRequest{HotelID: int32(id), Period: q.Get("period")}
+	if req.From, err = time.Parse(time.DateOnly, q.Get("from")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.To, err = time.Parse(time.DateOnly, q.Get("to")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if v := q.Get("by_room_type"); v != "" {
+		if req.ByRoomType, err = strconv.ParseBool(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	format := q.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "format must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	res, err := u.B.GetReport(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidReport) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, models.ErrHotelNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=report-%d-%s.csv", id, req.From.Format(time.DateOnly)))
+		if err := writeReport(w, res); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// writeReport writes the report rows as CSV, one line per period and room type.
+func writeReport(w io.Writer, rep *models.Report) error {
+	out := csv.NewWriter(w)
+	header := []string{
+		"period_start", "period_end", "room_type", "rooms", "room_nights_available", "room_nights_sold",
+		"occupancy_rate", "revenue", "adr", "revpar", "arrivals", "cancellations", "cancellation_rate", "avg_lead_time_days",
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	number := func(v float32) string { return strconv.FormatFloat(float64(v), 'f', 2, 32) }
+	rate := func(v float32) string { return strconv.FormatFloat(float64(v), 'f', 4, 32) }
+	for _, v := range rep.Rows {
+		row := []string{
+			v.PeriodStart.Format(time.DateOnly),
+			v.PeriodEnd.Format(time.DateOnly),
+			v.RoomType,
+			strconv.Itoa(int(v.Rooms)),
+			strconv.Itoa(int(v.RoomNightsAvailable)),
+			strconv.Itoa(int(v.RoomNightsSold)),
+			rate(v.OccupancyRate),
+			number(v.Revenue),
+			number(v.ADR),
+			number(v.RevPAR),
+			strconv.Itoa(int(v.Arrivals)),
+			strconv.Itoa(int(v.Cancellations)),
+			rate(v.CancellationRate),
+			number(v.AvgLeadTimeDays),
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// CreateBooking creates a new hotel booking.
+// @Summary Create a new hotel booking
+// @Description Create a new hotel booking
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param request body models.BookHotelRequest true "Booking details"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings [post]
+func (u *Handler) CreateBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req models.BookHotelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if req.UserID == 0 {
+		req.UserID = token.FromContext(r.Context()).UserID
+	}
+	if !authorize(w, r, req.UserID) {
+		return
+	}
+	res, err := u.B.CreateBooking(&req, caller(r), commandKey(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// GetBooking retrieves booking details by ID.
+// @Summary Get booking details
+// @Description Get booking details by ID
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.GetUsersBookResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/{id} [get]
+func (u *Handler) GetBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res, err := u.B.GetBooking(&models.GetUsersBookRequest{ID: int32(id)})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !authorize(w, r, res.UserID) {
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// UpdateBooking updates an existing hotel booking.
+// @Summary Update hotel booking
+// @Description Update an existing hotel booking by ID
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Booking ID"
+// @Param request body models.BookHotelUpdateRequest true "Updated booking details"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/{id} [put]
+func (u *Handler) UpdateBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !u.authorizeBooking(w, r, int32(id)) {
+		return
+	}
+	var req models.BookHotelUpdateRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.ID = int32(id)
+	res, err := u.B.UpdateBooking(&req, caller(r), commandKey(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// DeleteBooking cancels a hotel booking by ID.
+// @Summary Cancel hotel booking
+// @Description Cancel a hotel booking by ID. The booking is kept with a cancelled status and the refund and fee computed from the hotel's cancellation policy.
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Booking ID"
+// @Param reason query string false "Cancellation reason"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/{id} [delete]
+func (u *Handler) DeleteBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !u.authorizeBooking(w, r, int32(id)) {
+		return
+	}
+	res, err := u.B.DeleteBooking(&models.CancelRoomRequest{ID: int32(id), Reason: r.URL.Query().Get("reason")}, caller(r), commandKey(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// CheckAvailability lists the rooms that are free for a whole stay.
+// @Summary Check room availability
+// @Description List the rooms of a hotel that have no booking overlapping the requested dates
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param hotel query int true "Hotel ID"
+// @Param room_type query string false "Room type"
+// @Param check_in query string true "Check-in date (YYYY-MM-DD)"
+// @Param check_out query string true "Check-out date (YYYY-MM-DD)"
+// @Success 200 {object} models.CheckAvailabilityResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/availability [get]
+func (u *Handler) CheckAvailability(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	hotelid, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	checkIn, err := time.Parse(time.DateOnly, r.URL.Query().Get("check_in"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	checkOut, err := time.Parse(time.DateOnly, r.URL.Query().Get("check_out"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := u.B.CheckAvailability(&models.CheckAvailabilityRequest{
+		HotelID:      int32(hotelid),
+		RoomType:     r.URL.Query().Get("room_type"),
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// Search finds hotels with a room free for the whole stay.
+// @Summary Search available hotels
+// @Description Find hotels that have at least one room matching the filters free for the whole stay, with the lowest nightly price among those rooms
+// @Tags search
+// @Accept  json
+// @Produce  json
+// @Param location query string false "Part of the hotel location"
+// @Param check_in query string true "Check-in date (YYYY-MM-DD)"
+// @Param check_out query string true "Check-out date (YYYY-MM-DD)"
+// @Param guests query int false "Number of guests a room must sleep"
+// @Param room_type query string false "Room type"
+// @Param min_price query number false "Minimum nightly price"
+// @Param max_price query number false "Maximum nightly price"
+// @Param min_rating query int false "Minimum hotel rating"
+// @Param amenities query string false "Comma-separated amenity codes the hotel or room must all have, e.g. wifi,parking"
+// @Param sort query string false "price_asc (default), price_desc or rating_desc"
+// @Param page query int false "Page number, from 1"
+// @Param page_size query int false "Hotels per page, 20 by default, at most 100"
+// @Success 200 {object} models.SearchResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /search [get]
+func (u *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	q := r.URL.Query()
+	req := models.SearchRequest{Location: q.Get("location"), RoomType: q.Get("room_type"), Sort: q.Get("sort")}
+	if v := q.Get("amenities"); v != "" {
+		req.Amenities = strings.Split(v, ",")
+	}
+
+	var err error
+	if req.CheckInDate, err = time.Parse(time.DateOnly, q.Get("check_in")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.CheckOutDate, err = time.Parse(time.DateOnly, q.Get("check_out")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for name, v := range map[string]*int32{"guests": &req.Guests, "min_rating": &req.MinRating, "page": &req.Page, "page_size": &req.PageSize} {
+		if *v, err = intParam(q.Get(name)); err != nil {
+			http.Error(w, fmt.Sprintf("%s: %v", name, err), http.StatusBadRequest)
+			return
+		}
+	}
+	for name, v := range map[string]*float32{"min_price": &req.MinPrice, "max_price": &req.MaxPrice} {
+		if *v, err = floatParam(q.Get(name)); err != nil {
+			http.Error(w, fmt.Sprintf("%s: %v", name, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	res, err := u.B.SearchAvailability(&req)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidSearch) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	json.NewEncoder(w).Encode(res)
 }
 
-// UpdateRoom godoc
-// @Summary      Update room details
-// @Description  Update the details of a specific room
-// @Tags         rooms
-// @Accept       json
-// @Produce      json
-// @Param        room   body      models.UpdateRoomRequest  true  "Updated room details"
-// @Success      200    {string}  string                    "Room details are updated"
-// @Failure      500    {string}  string                    "Internal Server Error"
-// @Security     BearerAuth
-// @Router       /hotels/rooms/{id} [put]
-func (u *Handler) UpdateRoom(w http.ResponseWriter, r *http.Request) {
-	var req models.UpdateRoomRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+// intParam parses an optional query parameter, empty means zero.
+func intParam(v string) (int32, error) {
+	if v == "" {
+		return 0, nil
 	}
-	if err := u.B.UpdateRoom(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	n, err := strconv.ParseInt(v, 10, 32)
+	return int32(n), err
+}
+
+// floatParam parses an optional query parameter, empty means zero.
+func floatParam(v string) (float32, error) {
+	if v == "" {
+		return 0, nil
 	}
-	json.NewEncoder(w).Encode("Room details are updated")
+	n, err := strconv.ParseFloat(v, 32)
+	return float32(n), err
 }
 
-// DeleteRoom godoc
-// @Summary      Delete a room
-// @Description  Delete a specific room by hotel and room IDs
-// @Tags         rooms
-// @Accept       json
-// @Produce      json
-// @Param        hotel  query     int                     true  "Hotel ID"
-// @Param        room   query     int                     true  "Room ID"
-// @Success      200    {string}  string                  "Room is deleted"
-// @Failure      500    {string}  string                  "Internal Server Error"
-// @Security     BearerAuth
-// @Router       /hotels/rooms/{id} [delete]
-func (u *Handler) DeleteRoom(w http.ResponseWriter, r *http.Request) {
+// CheckIn checks the guest of a booking in.
+// @Summary Check in
+// @Description Move a confirmed booking to checked_in, not earlier than its check-in date
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/{id}/check-in [post]
+func (u *Handler) CheckIn(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	roomid, err := strconv.Atoi(r.URL.Query().Get("room"))
+	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	hotelid, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	res, err := u.B.CheckIn(&models.GetUsersBookRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	json.NewEncoder(w).Encode(res)
+}
 
-	if err := u.B.DeleteRoom(&models.GetRoomRequest{HotelID: int32(hotelid), ID: int32(roomid)}); err != nil {
+// AssignRoom gives a room to a booking made without one.
+// @Summary Assign a room
+// @Description Give a room of the booked type to a pending or confirmed booking made with assign_later. Without room_id a free room is picked.
+// @Tags bookings
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Booking ID"
+// @Param request body models.AssignRoomRequest false "Room to assign"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 409 {string} string "Conflict"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /bookings/{id}/assign-room [post]
+func (u *Handler) AssignRoom(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.AssignRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.ID = int32(id)
+	res, err := u.B.AssignRoom(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrRoomAssign) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	json.NewEncoder(w).Encode("Room is deleted")
+	json.NewEncoder(w).Encode(res)
 }
 
-// CreateBooking creates a new hotel booking.
-// @Summary Create a new hotel booking
-// @Description Create a new hotel booking
+// ReassignRoom moves a booking to another room.
+// @Summary Move a booking to another room
+// @Description Front-desk override: move a pending, confirmed or checked-in booking to a free room of its hotel, of any type. The price doesn't change.
 // @Tags bookings
 // @Accept  json
 // @Produce  json
-// @Param request body models.BookHotelRequest true "Booking details"
+// @Param id path int true "Booking ID"
+// @Param request body models.AssignRoomRequest true "New room"
 // @Success 200 {object} models.GeneralResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 409 {string} string "Conflict"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
-// @Router /bookings [post]
-func (u *Handler) CreateBooking(w http.ResponseWriter, r *http.Request) {
+// @Router /bookings/{id}/room [put]
+func (u *Handler) ReassignRoom(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-
-	var req models.BookHotelRequest
-
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req models.AssignRoomRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.RoomID == 0 {
+		http.Error(w, "room_id is required", http.StatusBadRequest)
 		return
 	}
-	res, err := u.B.CreateBooking(&req)
+	req.ID = int32(id)
+	res, err := u.B.ReassignRoom(&req)
 	if err != nil {
-		log.Println(err)
+		if errors.Is(err, models.ErrRoomAssign) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 	json.NewEncoder(w).Encode(res)
 }
 
-// GetBooking retrieves booking details by ID.
-// @Summary Get booking details
-// @Description Get booking details by ID
+// CheckOut checks the guest of a booking out.
+// @Summary Check out
+// @Description Move a checked_in booking to checked_out, freeing the remaining nights on an early departure
 // @Tags bookings
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Booking ID"
-// @Success 200 {object} models.GetUsersBookResponse
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
-// @Router /bookings/{id} [get]
-func (u *Handler) GetBooking(w http.ResponseWriter, r *http.Request) {
+// @Router /bookings/{id}/check-out [post]
+func (u *Handler) CheckOut(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	res, err := u.B.GetBooking(&models.GetUsersBookRequest{ID: int32(id)})
+	res, err := u.B.CheckOut(&models.GetUsersBookRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -531,33 +2066,26 @@ func (u *Handler) GetBooking(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
-// UpdateBooking updates an existing hotel booking.
-// @Summary Update hotel booking
-// @Description Update an existing hotel booking by ID
+// MarkNoShow marks a booking whose guest never arrived.
+// @Summary Mark no-show
+// @Description Move a confirmed booking to no_show and release its room
 // @Tags bookings
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Booking ID"
-// @Param request body models.BookHotelUpdateRequest true "Updated booking details"
 // @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
-// @Router /bookings/{id} [put]
-func (u *Handler) UpdateBooking(w http.ResponseWriter, r *http.Request) {
+// @Router /bookings/{id}/no-show [post]
+func (u *Handler) MarkNoShow(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	var req models.BookHotelUpdateRequest
-	req.ID = int32(id)
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	res, err := u.B.UpdateBooking(&req)
+	res, err := u.B.MarkNoShow(&models.GetUsersBookRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -565,25 +2093,29 @@ func (u *Handler) UpdateBooking(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
-// DeleteBooking deletes a hotel booking by ID.
-// @Summary Delete hotel booking
-// @Description Delete a hotel booking by ID
+// GetPayment returns the payment of a booking.
+// @Summary Get booking payment
+// @Description Get the current payment of a booking with its status history
 // @Tags bookings
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Booking ID"
-// @Success 200 {object} models.GeneralResponse
+// @Success 200 {object} models.Payment
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
-// @Router /bookings/{id} [delete]
-func (u *Handler) DeleteBooking(w http.ResponseWriter, r *http.Request) {
+// @Router /bookings/{id}/payment [get]
+func (u *Handler) GetPayment(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	res, err := u.B.DeleteBooking(&models.CancelRoomRequest{ID: int32(id)})
+	if !u.authorizeBooking(w, r, int32(id)) {
+		return
+	}
+	res, err := u.B.GetPayment(&models.GetUsersBookRequest{ID: int32(id)})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -598,7 +2130,9 @@ func (u *Handler) DeleteBooking(w http.ResponseWriter, r *http.Request) {
 // @Accept  json
 // @Produce  json
 // @Param request body models.CreateWaitingList true "Waiting list details"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /waitinglists [post]
@@ -611,7 +2145,13 @@ func (u *Handler) CreateWaiting(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	res, err := u.B.CreateWaitinglist(&req)
+	if req.UserID == 0 {
+		req.UserID = token.FromContext(r.Context()).UserID
+	}
+	if !authorize(w, r, req.UserID) {
+		return
+	}
+	res, err := u.B.CreateWaitinglist(&req, caller(r), commandKey(r))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -628,6 +2168,7 @@ func (u *Handler) CreateWaiting(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path int true "Waiting List ID"
 // @Success 200 {object} models.GetWaitinglistResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /waitinglists/{id} [get]
@@ -643,9 +2184,219 @@ func (u *Handler) GetWaiting(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if !authorize(w, r, res.UserID) {
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// ListUserBookings godoc
+// @Summary      List the bookings of a user
+// @Description  A page of the bookings of a user. Pass next_cursor of the response as cursor to get the next page; bookings made meanwhile neither shift nor repeat entries.
+// @Tags         bookings
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "User ID"
+// @Param        status  query     string  false  "Only entries in this status"
+// @Param        from    query     string  false  "Only stays ending after this date, YYYY-MM-DD"
+// @Param        to      query     string  false  "Only stays starting before this date, YYYY-MM-DD"
+// @Param        sort    query     string  false  "check_in_asc (default), check_in_desc, newest or oldest"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
+// @Param        limit   query     int     false  "Page size, 20 by default and at most 100"
+// @Success      200     {object}  models.BookingList
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      403     {string}  string  "Forbidden"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /users/{id}/bookings [get]
+func (u *Handler) ListUserBookings(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	req, err := listRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !authorize(w, r, int32(id)) {
+		return
+	}
+	req.UserID = int32(id)
+	res, err := u.B.ListBookings(req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidList) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// ListHotelBookings godoc
+// @Summary      List the bookings of a hotel
+// @Description  A page of the bookings of a hotel, paged by cursor like the bookings of a user.
+// @Tags         bookings
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "Hotel ID"
+// @Param        status  query     string  false  "Only entries in this status"
+// @Param        from    query     string  false  "Only stays ending after this date, YYYY-MM-DD"
+// @Param        to      query     string  false  "Only stays starting before this date, YYYY-MM-DD"
+// @Param        sort    query     string  false  "check_in_asc (default), check_in_desc, newest or oldest"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
+// @Param        limit   query     int     false  "Page size, 20 by default and at most 100"
+// @Success      200     {object}  models.BookingList
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      403     {string}  string  "Forbidden"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/bookings/{id} [get]
+func (u *Handler) ListHotelBookings(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	req, err := listRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.HotelID = int32(id)
+	res, err := u.B.ListBookings(req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidList) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// ListUserWaitingList godoc
+// @Summary      List the waiting list entries of a user
+// @Description  A page of the waiting list entries of a user, paged by cursor.
+// @Tags         waitinglists
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "User ID"
+// @Param        status  query     string  false  "Only entries in this status"
+// @Param        from    query     string  false  "Only stays ending after this date, YYYY-MM-DD"
+// @Param        to      query     string  false  "Only stays starting before this date, YYYY-MM-DD"
+// @Param        sort    query     string  false  "check_in_asc (default), check_in_desc, newest or oldest"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
+// @Param        limit   query     int     false  "Page size, 20 by default and at most 100"
+// @Success      200     {object}  models.WaitingList
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      403     {string}  string  "Forbidden"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /users/{id}/waitinglists [get]
+func (u *Handler) ListUserWaitingList(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	req, err := listRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !authorize(w, r, int32(id)) {
+		return
+	}
+	req.UserID = int32(id)
+	res, err := u.B.ListWaitingList(req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidList) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// ListHotelWaitingList godoc
+// @Summary      List the waiting list of a hotel
+// @Description  A page of the waiting list entries of a hotel, paged by cursor.
+// @Tags         waitinglists
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "Hotel ID"
+// @Param        status  query     string  false  "Only entries in this status"
+// @Param        from    query     string  false  "Only stays ending after this date, YYYY-MM-DD"
+// @Param        to      query     string  false  "Only stays starting before this date, YYYY-MM-DD"
+// @Param        sort    query     string  false  "check_in_asc (default), check_in_desc, newest or oldest"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
+// @Param        limit   query     int     false  "Page size, 20 by default and at most 100"
+// @Success      200     {object}  models.WaitingList
+// @Failure      400     {string}  string  "Bad Request"
+// @Failure      403     {string}  string  "Forbidden"
+// @Failure      500     {string}  string  "Internal Server Error"
+// @Security     BearerAuth
+// @Router       /hotels/waitinglists/{id} [get]
+func (u *Handler) ListHotelWaitingList(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	req, err := listRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.HotelID = int32(id)
+	res, err := u.B.ListWaitingList(req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidList) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	json.NewEncoder(w).Encode(res)
 }
 
+// listRequest reads the filters and page of a list from the query string.
+func listRequest(r *http.Request) (*models.ListRequest, error) {
+	q := r.URL.Query()
+	req := &models.ListRequest{Status: q.Get("status"), Sort: q.Get("sort"), Cursor: q.Get("cursor")}
+	var err error
+	if v := q.Get("from"); v != "" {
+		if req.From, err = time.Parse(time.DateOnly, v); err != nil {
+			return nil, err
+		}
+	}
+	if v := q.Get("to"); v != "" {
+		if req.To, err = time.Parse(time.DateOnly, v); err != nil {
+			return nil, err
+		}
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		req.Limit = int32(limit)
+	}
+	return req, nil
+}
+
 // UpdateWaiting updates an entry in the waiting list.
 // @Summary Update waiting list
 // @Description Update an existing entry in the waiting list by ID
@@ -654,7 +2405,9 @@ func (u *Handler) GetWaiting(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path int true "Waiting List ID"
 // @Param request body models.UpdateWaitingListRequest true "Updated waiting list details"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /waitinglists/{id} [put]
@@ -663,16 +2416,22 @@ func (u *Handler) UpdateWaiting(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	entry, ok := u.authorizeWaiting(w, r, int32(id))
+	if !ok {
+		return
 	}
 	var req models.UpdateWaitingListRequest
-	req.ID = int32(id)
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	// The entry stays with its owner whatever the body says
+	req.ID, req.UserID = entry.ID, entry.UserID
 
-	res, err := u.B.UpdateWaiting(&req)
+	res, err := u.B.UpdateWaiting(&req, caller(r), commandKey(r))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -687,7 +2446,9 @@ func (u *Handler) UpdateWaiting(w http.ResponseWriter, r *http.Request) {
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Waiting List ID"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal Server Error"
 // @Security BearerAuth
 // @Router /waitinglists/{id} [delete]
@@ -696,11 +2457,84 @@ func (u *Handler) DeleteWaiting(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, ok := u.authorizeWaiting(w, r, int32(id)); !ok {
+		return
+	}
+	res, err := u.B.DeleteWaiting(&models.DeleteWaitingList{ID: int32(id)}, caller(r), commandKey(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// AcceptOffer books the room held for a waiting list entry.
+// @Summary Accept a waiting list offer
+// @Description Confirm the booking held for an offered waiting list entry before the offer expires
+// @Tags waitinglists
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Waiting List ID"
+// @Param request body models.AcceptOfferRequest true "Payment details"
+// @Success 200 {object} models.GeneralResponse
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /waitinglists/{id}/accept [post]
+func (u *Handler) AcceptOffer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, ok := u.authorizeWaiting(w, r, int32(id)); !ok {
+		return
+	}
+
+	var req models.AcceptOfferRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.ID = int32(id)
+	res, err := u.B.AcceptOffer(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-	res, err := u.B.DeleteWaiting(&models.DeleteWaitingList{ID: int32(id)})
+	json.NewEncoder(w).Encode(res)
+}
+
+// GetCommandStatus returns the result of an asynchronous request.
+// @Summary Get command status
+// @Description Get the result of a request that was queued for processing, by the command id it returned
+// @Tags commands
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Command ID"
+// @Success 200 {object} models.CommandStatus
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Command not found or not processed yet"
+// @Failure 500 {string} string "Internal Server Error"
+// @Security BearerAuth
+// @Router /commands/{id} [get]
+func (u *Handler) GetCommandStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	res, err := u.B.GetCommandStatus(r.PathValue("id"))
 	if err != nil {
+		if errors.Is(err, models.ErrCommandNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if !authorize(w, r, res.UserID) {
+		return
+	}
 	json.NewEncoder(w).Encode(res)
 }
@@ -0,0 +1,99 @@
+package router
+
+import (
+	broad "api-gateway/internal/broadcast"
+	"api-gateway/models"
+	token "api-gateway/utils/jwt"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// hotelOf finds the hotel a request acts on.
+type hotelOf func(r *http.Request) (int32, error)
+
+// policy decides per route who may call it, behind token.JWTMiddleware.
+// Routes without a policy are open to every logged-in user; handlers still
+// check that guests only touch their own records.
+type policy struct {
+	b *broad.Adjust
+}
+
+// admin lets only admins through.
+func (p *policy) admin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !token.FromContext(r.Context()).HasRole(models.RoleAdmin) {
+			http.Error(w, models.ErrForbidden.Error(), http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// staff lets through admins and the managers of the hotel the request acts on.
+func (p *policy) staff(hotel hotelOf, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims := token.FromContext(r.Context())
+		if claims.HasRole(models.RoleAdmin) {
+			next(w, r)
+			return
+		}
+		if !claims.HasRole(models.RoleManager) {
+			http.Error(w, models.ErrForbidden.Error(), http.StatusForbidden)
+			return
+		}
+		id, err := hotel(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !claims.Manages(id) {
+			http.Error(w, models.ErrForbidden.Error(), http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// pathHotel reads the hotel from the {id} of the route.
+func pathHotel(r *http.Request) (int32, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	return int32(id), err
+}
+
+// queryHotel reads the hotel from the hotel query parameter.
+func queryHotel(r *http.Request) (int32, error) {
+	id, err := strconv.Atoi(r.URL.Query().Get("hotel"))
+	return int32(id), err
+}
+
+// bodyHotel reads the hotel_id of a JSON body and leaves the body for the handler.
+func bodyHotel(r *http.Request) (int32, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return 0, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	var req struct {
+		HotelID int32 `json:"hotel_id"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return 0, err
+	}
+	return req.HotelID, nil
+}
+
+// bookingHotel looks up the hotel of the booking {id}.
+func (p *policy) bookingHotel(r *http.Request) (int32, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, err
+	}
+	res, err := p.b.GetBooking(&models.GetUsersBookRequest{ID: int32(id)})
+	if err != nil {
+		return 0, err
+	}
+	return res.HotelID, nil
+}
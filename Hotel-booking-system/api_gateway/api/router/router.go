@@ -4,6 +4,7 @@ import (
 	"api-gateway/config"
 	"api-gateway/internal/connections"
 	_ "api-gateway/internal/docs"
+	"api-gateway/utils/idempotency"
 	token "api-gateway/utils/jwt"
 	"fmt"
 	"log"
@@ -24,48 +25,101 @@ import (
 func NewRouter() {
 	c := config.Configuration()
 
+	keys, err := token.LoadKeys(c.Jwt.KeysDir, c.Jwt.SigningKey)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	token.UseKeys(keys)
+
 	r := http.NewServeMux()
 	handler := connections.NewHandler()
+	p := &policy{b: handler.B}
+	token.UseRevocations(handler.B.R)
 
 	// Users
 
 	r.HandleFunc("POST /users/register", handler.Register)
 	r.HandleFunc("POST /users/verify", handler.Verify)
+	r.HandleFunc("POST /users/verify/resend", handler.ResendCode)
 	r.HandleFunc("POST /users/login", handler.LogIn)
+	r.HandleFunc("POST /users/password/forgot", handler.ForgotPassword)
+	r.HandleFunc("POST /users/password/reset", handler.ResetPassword)
 	r.HandleFunc("GET /users/{id}", token.JWTMiddleware(handler.GetUser))
 	r.HandleFunc("PUT /users/{id}", token.JWTMiddleware(handler.UpdateUser))
 	r.HandleFunc("DELETE /users/{id}", token.JWTMiddleware(handler.DeleteUser))
 	r.HandleFunc("POST /users/logout/{id}", token.JWTMiddleware(handler.LogOut))
+	r.HandleFunc("POST /users/unlock/{id}", token.JWTMiddleware(p.admin(handler.UnlockAccount)))
+	r.HandleFunc("POST /users/roles/{id}", token.JWTMiddleware(p.admin(handler.GrantRole)))
+	r.HandleFunc("DELETE /users/roles/{id}", token.JWTMiddleware(p.admin(handler.RevokeRole)))
+	r.HandleFunc("GET /users/{id}/bookings", token.JWTMiddleware(handler.ListUserBookings))
+	r.HandleFunc("GET /users/{id}/waitinglists", token.JWTMiddleware(handler.ListUserWaitingList))
+	r.HandleFunc("POST /auth/refresh", handler.Refresh)
+	r.HandleFunc("POST /auth/logout-all", token.JWTMiddleware(handler.LogOutAll))
+	r.HandleFunc("GET /.well-known/jwks.json", handler.JWKS)
 	r.Handle("/swagger/", swag.WrapHandler)
 
 	// Hotel
 
-	r.HandleFunc("POST /hotels/create", token.JWTMiddleware(handler.CreateHotel))
-	r.HandleFunc("POST /hotels/rooms/create", token.JWTMiddleware(handler.CreateRoom))
+	r.HandleFunc("POST /hotels/create", token.JWTMiddleware(p.admin(handler.CreateHotel)))
+	r.HandleFunc("POST /hotels/rooms/create", token.JWTMiddleware(p.staff(bodyHotel, handler.CreateRoom)))
 	r.HandleFunc("GET /hotels", token.JWTMiddleware(handler.GetHotels))
 	r.HandleFunc("GET /hotels/{id}", token.JWTMiddleware(handler.GetHotel))
+	r.HandleFunc("GET /hotels/nearby", token.JWTMiddleware(handler.NearbyHotels))
+	r.HandleFunc("PUT /hotels/amenities/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.SetHotelAmenities)))
+	r.HandleFunc("PUT /hotels/rooms/amenities/{id}", token.JWTMiddleware(p.staff(bodyHotel, handler.SetRoomAmenities)))
+	r.HandleFunc("POST /amenities", token.JWTMiddleware(p.admin(handler.CreateAmenity)))
+	r.HandleFunc("GET /amenities", token.JWTMiddleware(handler.ListAmenities))
 	r.HandleFunc("GET /hotels/rooms/{id}", token.JWTMiddleware(handler.GetRooms))
 	r.HandleFunc("GET /hotels/room", token.JWTMiddleware(handler.GetRoom))
-	r.HandleFunc("PUT /hotels/{id}", token.JWTMiddleware(handler.UpdateHotel))
-	r.HandleFunc("PUT /hotels/rooms/{id}", token.JWTMiddleware(handler.UpdateRoom))
-	r.HandleFunc("DELETE /hotels/{id}", token.JWTMiddleware(handler.DeleteHotel))
-	r.HandleFunc("DELETE /hotels/rooms/{id}", token.JWTMiddleware(handler.DeleteRoom))
+	r.HandleFunc("PUT /hotels/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.UpdateHotel)))
+	r.HandleFunc("PUT /hotels/rooms/{id}", token.JWTMiddleware(p.staff(bodyHotel, handler.UpdateRoom)))
+	r.HandleFunc("DELETE /hotels/{id}", token.JWTMiddleware(p.admin(handler.DeleteHotel)))
+	r.HandleFunc("DELETE /hotels/rooms/{id}", token.JWTMiddleware(p.staff(queryHotel, handler.DeleteRoom)))
+	r.HandleFunc("PUT /hotels/cancellation-policy/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.SetCancellationPolicy)))
+	r.HandleFunc("GET /hotels/cancellation-policy/{id}", token.JWTMiddleware(handler.GetCancellationPolicy))
+	r.HandleFunc("POST /hotels/rates/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.CreateRate)))
+	r.HandleFunc("GET /hotels/rates/{id}", token.JWTMiddleware(handler.GetRatePlan))
+	r.HandleFunc("DELETE /hotels/rates/{id}/{rate_id}", token.JWTMiddleware(p.staff(pathHotel, handler.DeleteRate)))
+	r.HandleFunc("POST /hotels/stay-discounts/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.CreateStayDiscount)))
+	r.HandleFunc("DELETE /hotels/stay-discounts/{id}/{discount_id}", token.JWTMiddleware(p.staff(pathHotel, handler.DeleteStayDiscount)))
+	r.HandleFunc("GET /hotels/quote/{id}", token.JWTMiddleware(handler.Quote))
+	r.HandleFunc("POST /hotels/room-types/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.CreateRoomType)))
+	r.HandleFunc("GET /hotels/room-types/{id}", token.JWTMiddleware(handler.GetRoomTypes))
+	r.HandleFunc("PUT /hotels/room-types/{id}/{type_id}", token.JWTMiddleware(p.staff(pathHotel, handler.UpdateRoomType)))
+	r.HandleFunc("DELETE /hotels/room-types/{id}/{type_id}", token.JWTMiddleware(p.staff(pathHotel, handler.DeleteRoomType)))
+	r.HandleFunc("GET /hotels/calendar/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.GetOccupancyCalendar)))
+	r.HandleFunc("GET /hotels/reports/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.GetReport)))
+	r.HandleFunc("GET /hotels/bookings/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.ListHotelBookings)))
+	r.HandleFunc("GET /hotels/waitinglists/{id}", token.JWTMiddleware(p.staff(pathHotel, handler.ListHotelWaitingList)))
 
 	//Booking
 
 	r.HandleFunc("POST /bookings", token.JWTMiddleware(handler.CreateBooking))
 	r.HandleFunc("POST /waitinglists", token.JWTMiddleware(handler.CreateWaiting))
+	r.HandleFunc("GET /bookings/availability", token.JWTMiddleware(handler.CheckAvailability))
 	r.HandleFunc("GET /bookings/{id}", token.JWTMiddleware(handler.GetBooking))
 	r.HandleFunc("GET /waitinglists/{id}", token.JWTMiddleware(handler.GetWaiting))
 	r.HandleFunc("PUT /bookings/{id}", token.JWTMiddleware(handler.UpdateBooking))
 	r.HandleFunc("PUT /waitinglists/{id}", token.JWTMiddleware(handler.UpdateWaiting))
 	r.HandleFunc("DELETE /bookings/{id}", token.JWTMiddleware(handler.DeleteBooking))
+	r.HandleFunc("POST /bookings/{id}/assign-room", token.JWTMiddleware(p.staff(p.bookingHotel, handler.AssignRoom)))
+	r.HandleFunc("PUT /bookings/{id}/room", token.JWTMiddleware(p.staff(p.bookingHotel, handler.ReassignRoom)))
+	r.HandleFunc("POST /bookings/{id}/check-in", token.JWTMiddleware(p.staff(p.bookingHotel, handler.CheckIn)))
+	r.HandleFunc("POST /bookings/{id}/check-out", token.JWTMiddleware(p.staff(p.bookingHotel, handler.CheckOut)))
+	r.HandleFunc("POST /bookings/{id}/no-show", token.JWTMiddleware(p.staff(p.bookingHotel, handler.MarkNoShow)))
+	r.HandleFunc("GET /bookings/{id}/payment", token.JWTMiddleware(handler.GetPayment))
 	r.HandleFunc("DELETE /waitinglists/{id}", token.JWTMiddleware(handler.DeleteWaiting))
+	r.HandleFunc("POST /waitinglists/{id}/accept", token.JWTMiddleware(handler.AcceptOffer))
+	r.HandleFunc("GET /commands/{id}", token.JWTMiddleware(handler.GetCommandStatus))
+
+	// Search
+
+	r.HandleFunc("GET /search", token.JWTMiddleware(handler.Search))
 
 	certfile := "./cert/api.pem"
 	keyfile := "./cert/api-key.pem"
 	fmt.Printf("Server started on port %s\n", c.User.Port)
-	if err := http.ListenAndServeTLS(c.User.Port, certfile, keyfile, r); err != nil {
+	if err := http.ListenAndServeTLS(c.User.Port, certfile, keyfile, idempotency.Middleware(handler.B.R, r)); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
@@ -9,6 +9,11 @@ type Config struct {
 		Host string
 		Port string
 	}
+	// Jwt is where the token signing keys are, see jwttoken.LoadKeys.
+	Jwt struct {
+		KeysDir    string
+		SigningKey string
+	}
 }
 
 func Configuration() *Config {
@@ -17,6 +22,9 @@ func Configuration() *Config {
 	c.User.Host = osGetenv("HOST", "localhost")
 	c.User.Port = osGetenv("PORT", "8085")
 
+	c.Jwt.KeysDir = osGetenv("JWT_KEYS_DIR", "./cert/jwt")
+	c.Jwt.SigningKey = osGetenv("JWT_SIGNING_KEY", "")
+
 	return c
 }
 
@@ -10,10 +10,19 @@ import (
 	mail "api-gateway/utils/email"
 	token "api-gateway/utils/jwt"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Adjust struct {
@@ -24,74 +33,336 @@ type Adjust struct {
 	Ctx context.Context
 }
 
+const (
+	// signupTTL is how long a signup waits for its code, counted again from
+	// every resent code.
+	signupTTL          = 10 * time.Minute
+	signupCodeCooldown = time.Minute
+	signupMaxResends   = 5
+	signupMaxAttempts  = 5
+	signupLockout      = 15 * time.Minute
+)
+
+// Register keeps the signup, with the password hashed, and emails the code
+// that creates the account.
 func (a *Adjust) Register(req *models.RegisterUserRequest) error {
-	if _, err := a.R.RegisterGet(req.Email); err != nil {
-		if err := a.R.Register(req); err != nil {
-			log.Println(err)
-			return err
-		}
-		code := mail.Sent(req.Email)
-		if err := a.R.VerifyCodeRequest(&models.VerifyRequest{Email: req.Email, Code: code}); err != nil {
+	if len(req.Password) < minPasswordLength {
+		return fmt.Errorf("%w: use at least %d characters", models.ErrWeakPassword, minPasswordLength)
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	signup := &models.PendingSignup{Username: req.Username, Age: req.Age, Email: req.Email, PasswordHash: string(hashed)}
+	if err := a.R.StartSignup(signup, signupTTL, signupCodeCooldown); err != nil {
+		return err
+	}
+	return a.sendSignupCode(req.Email)
+}
+
+// ResendCode emails a new code for a waiting signup; the old code stops working.
+func (a *Adjust) ResendCode(req *models.ResendCodeRequest) error {
+	if err := a.R.StartSignupResend(req.Email, signupCodeCooldown, signupMaxResends); err != nil {
+		return err
+	}
+	return a.sendSignupCode(req.Email)
+}
+
+func (a *Adjust) sendSignupCode(email string) error {
+	code := mail.Sent(email)
+	if err := a.R.SaveSignupCode(email, codeHash(email, code), signupTTL); err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+func (a *Adjust) Verify(req *models.VerifyRequest, idempotencyKey string) (string, error) {
+	signup, err := a.R.UseSignupCode(req.Email, codeHash(req.Email, req.Code), signupMaxAttempts, signupLockout)
+	if err != nil {
+		if !errors.Is(err, models.ErrInvalidCode) && !errors.Is(err, models.ErrTooManyAttempts) {
 			log.Println(err)
-			return err
 		}
-		return nil
+		return "", err
 	}
-	return errors.New("this email already exists")
+	return a.command("create", signup, 0, idempotencyKey)
 }
 
-func (a *Adjust) Verify(req *models.VerifyRequest) error {
-	res, err := a.R.VerifyCodeResponse(req)
+// command publishes a user command to Kafka under a new command id issued
+// to caller, zero for a signup. A non-empty idempotencyKey lets the user
+// service skip repeated requests.
+func (a *Adjust) command(key string, req any, caller int32, idempotencyKey string) (string, error) {
+	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
-		return err
+		return "", err
+	}
+	id := producer.NewCommandID()
+	if err := producer.Producer(key, data, producer.Headers(id, idempotencyKey, caller)...); err != nil {
+		log.Println(err)
+		return "", err
 	}
+	return id, nil
+}
+
+const (
+	// loginWindow is how long failed logins are counted.
+	loginWindow = 15 * time.Minute
+	// After the free failures every failure doubles the wait before the
+	// next try, from a second up to loginMaxWait.
+	loginFreeFailures   = 3
+	loginIPFreeFailures = 20
+	loginMaxWait        = 5 * time.Minute
+	// loginLockFailures failures lock the account for loginLockout.
+	loginLockFailures = 10
+	loginLockout      = 30 * time.Minute
+)
 
-	if res != req.Code {
-		return errors.New("password or email doesn't match")
+// Login starts a session and returns its first access and refresh tokens.
+// Failed logins slow down further tries to the account and from ip, and
+// too many lock the account.
+func (a *Adjust) Login(req *models.LogInRequest, ip string) (*models.TokenResponse, error) {
+	wait, err := a.R.LoginWait(req.Email, ip)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if wait > 0 {
+		return nil, &models.RetryError{Err: models.ErrLoginThrottled, After: wait}
 	}
 
-	user, err := a.R.RegisterGet(req.Email)
+	res, err := a.U.LogIn(a.Ctx, &user.LogInRequest{Email: req.Email, Password: req.Password})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.Unauthenticated:
+		a.loginFailed(req.Email, ip)
+		return nil, models.ErrBadCredentials
+	case codes.PermissionDenied:
+		return nil, statusError(err, codes.PermissionDenied, models.ErrAccountLocked)
+	default:
+		log.Println(err)
+		return nil, err
+	}
+	if !res.Status {
+		return nil, models.ErrBadCredentials
+	}
+	if err := a.R.ClearLoginFailures(req.Email); err != nil {
+		log.Println(err)
+	}
+
+	id, err := token.NewSessionID()
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
+	return a.issue(&models.Session{ID: id, UserID: res.Id, Email: req.Email, Roles: res.Roles, HotelIDs: res.HotelIds})
+}
 
-	return a.CreateUser(user)
+// loginFailed counts a failed login, delays the next tries and locks the
+// account when it failed loginLockFailures times.
+func (a *Adjust) loginFailed(email, ip string) {
+	account, address, err := a.R.LoginFailed(email, ip, loginWindow)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if wait := loginWait(account, loginFreeFailures); wait > 0 {
+		if err := a.R.DelayLogins(email, "", wait); err != nil {
+			log.Println(err)
+		}
+	}
+	if wait := loginWait(address, loginIPFreeFailures); wait > 0 {
+		if err := a.R.DelayLogins("", ip, wait); err != nil {
+			log.Println(err)
+		}
+	}
+	if locksAccount(account) {
+		// The user service emails the owner; unknown emails have nothing to lock
+		_, err := a.U.LockAccount(a.Ctx, &user.LockRequest{Email: email, Until: timestamppb.New(time.Now().Add(loginLockout))})
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Println(err)
+		}
+	}
 }
 
-func (a *Adjust) CreateUser(req *models.RegisterUserRequest) error {
-	data, err := json.Marshal(req)
+// locksAccount reports whether the failure that brought an account to
+// failures locks it. Only the one reaching loginLockFailures does, so later
+// failures in the window do not extend the lock.
+func locksAccount(failures int64) bool {
+	return failures == loginLockFailures
+}
+
+func loginWait(failures, free int64) time.Duration {
+	if failures <= free {
+		return 0
+	}
+	return min(time.Second<<min(failures-free-1, 16), loginMaxWait)
+}
+
+// UnlockAccount lifts the lock of an account and forgets its failed logins.
+func (a *Adjust) UnlockAccount(req *models.GetUserRequest) (*models.AccountLock, error) {
+	res, err := a.U.UnlockAccount(a.Ctx, &user.GetUserRequest{Id: req.ID})
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			log.Println(err)
+			return nil, models.ErrUserNotFound
+		}
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return producer.Producer("create", data)
+	if err := a.R.ClearLoginFailures(res.Email); err != nil {
+		log.Println(err)
+	}
+	return &models.AccountLock{ID: res.Id, Email: res.Email}, nil
 }
 
-func (a *Adjust) Login(req *models.LogInRequest) (map[string]string, error) {
-	res, err := a.U.LogIn(a.Ctx, &user.LogInRequest{Email: req.Email, Password: req.Password})
+// Refresh trades a refresh token for new tokens of the same session. Each
+// refresh token works once: presenting one that was already rotated means
+// it leaked, so the whole session is revoked.
+func (a *Adjust) Refresh(req *models.RefreshRequest) (*models.TokenResponse, error) {
+	hash := token.HashRefreshToken(req.RefreshToken)
+	session, err := a.R.TakeSession(hash)
+	if errors.Is(err, redis.Nil) {
+		if id, err := a.R.UsedRefresh(hash); err == nil {
+			log.Printf("refresh token of session %s used twice, revoking it", id)
+			if err := a.R.RevokeSession(id, token.AccessTTL); err != nil {
+				log.Println(err)
+				return nil, err
+			}
+		}
+		return nil, models.ErrInvalidRefresh
+	}
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	if err := a.R.MarkRefreshUsed(hash, session.ID, token.RefreshTTL); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return a.issue(session)
+}
 
-	if res.Status {
-		token, err := token.CreateToken(req)
-		if err != nil {
+// issue signs an access token for the session and stores a new refresh token.
+func (a *Adjust) issue(session *models.Session) (*models.TokenResponse, error) {
+	access, err := token.CreateToken(&token.Claims{
+		UserID:    session.UserID,
+		Email:     session.Email,
+		Roles:     session.Roles,
+		HotelIDs:  session.HotelIDs,
+		SessionID: session.ID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	refresh, hash, err := token.NewRefreshToken()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if err := a.R.SaveSession(hash, session, token.RefreshTTL); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.TokenResponse{
+		UserID:       session.UserID,
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(token.AccessTTL.Seconds()),
+	}, nil
+}
+
+const (
+	resetCodeTTL      = 15 * time.Minute
+	resetCodeCooldown = time.Minute
+	resetMaxAttempts  = 5
+	// minPasswordLength matches the user service, checked here too so a
+	// weak password doesn't use up the code
+	minPasswordLength = 8
+)
+
+// ForgotPassword emails a one-time password reset code. It answers the same
+// whether or not the email has an account.
+func (a *Adjust) ForgotPassword(req *models.ForgotPasswordRequest) error {
+	if err := a.R.StartResetCode(req.Email, resetCodeCooldown); err != nil {
+		if !errors.Is(err, models.ErrCodeCooldown) {
 			log.Println(err)
-			return nil, err
 		}
+		return err
+	}
+	code := mail.SentPasswordReset(req.Email)
+	if err := a.R.SaveResetCode(req.Email, codeHash(req.Email, code), resetCodeTTL); err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
 
-		lastUserRes, err := a.U.LastInserted(a.Ctx, &user.LastInsertedUser{})
-		if err != nil {
+// ResetPassword sets a new password with an emailed code and ends every
+// session of the account.
+func (a *Adjust) ResetPassword(req *models.ResetPasswordRequest) error {
+	if len(req.Password) < minPasswordLength {
+		return fmt.Errorf("%w: use at least %d characters", models.ErrWeakPassword, minPasswordLength)
+	}
+	if err := a.R.UseResetCode(req.Email, codeHash(req.Email, req.Code), resetMaxAttempts); err != nil {
+		return err
+	}
+	res, err := a.U.ResetPassword(a.Ctx, &user.ResetPasswordRequest{Email: req.Email, Password: req.Password})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
 			log.Println(err)
-			return nil, err
+			return models.ErrInvalidResetCode
 		}
+		return invalidArgument(err, models.ErrWeakPassword)
+	}
+	if err := a.R.RevokeUser(res.Id, token.AccessTTL); err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+// codeHash is how emailed codes are stored, tied to their email.
+func codeHash(email, code string) string {
+	sum := sha256.Sum256([]byte(email + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
 
-		return map[string]string{fmt.Sprintf("your account is created with this id %v", lastUserRes.Id): token}, nil
+// GrantRole gives a user a role. Their sessions are ended, so the next
+// login carries the new role.
+func (a *Adjust) GrantRole(req *models.RoleRequest) (*models.UserRoles, error) {
+	res, err := a.U.GrantRole(a.Ctx, &user.RoleRequest{UserId: req.UserID, Role: req.Role, HotelId: req.HotelID})
+	if err != nil {
+		return nil, roleError(err)
+	}
+	if err := a.R.RevokeUser(req.UserID, token.AccessTTL); err != nil {
+		log.Println(err)
+		return nil, err
 	}
-	return nil, errors.New("password or email doesn't match or is missing")
+	return &models.UserRoles{UserID: res.UserId, Roles: res.Roles, HotelIDs: res.HotelIds}, nil
+}
+
+func (a *Adjust) RevokeRole(req *models.RoleRequest) (*models.UserRoles, error) {
+	res, err := a.U.RevokeRole(a.Ctx, &user.RoleRequest{UserId: req.UserID, Role: req.Role, HotelId: req.HotelID})
+	if err != nil {
+		return nil, roleError(err)
+	}
+	if err := a.R.RevokeUser(req.UserID, token.AccessTTL); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.UserRoles{UserID: res.UserId, Roles: res.Roles, HotelIDs: res.HotelIds}, nil
+}
+
+func roleError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		log.Println(err)
+		return models.ErrUserNotFound
+	}
+	return invalidArgument(err, models.ErrInvalidRole)
 }
 
 func (a *Adjust) GetUser(req *models.GetUserRequest) (*models.GetUserResponse, error) {
@@ -113,16 +384,10 @@ func (a *Adjust) GetUser(req *models.GetUserRequest) (*models.GetUserResponse, e
 	return &models.GetUserResponse{ID: userData.ID, Username: userData.Username, Age: userData.Age, Email: userData.Email, LogOut: userData.LogOut}, nil
 }
 
-func (a *Adjust) UpdateUser(req *models.UpdateUserRequest) error {
-	data, err := json.Marshal(req)
+func (a *Adjust) UpdateUser(req *models.UpdateUserRequest, caller int32, idempotencyKey string) (string, error) {
+	id, err := a.command("update", req, caller, idempotencyKey)
 	if err != nil {
-		log.Println(err)
-		return err
-	}
-
-	if err := producer.Producer("update", data); err != nil {
-		log.Println(err)
-		return err
+		return "", err
 	}
 
 	res, err := a.U.GetUser(a.Ctx, &user.GetUserRequest{Id: req.ID})
@@ -130,29 +395,81 @@ func (a *Adjust) UpdateUser(req *models.UpdateUserRequest) error {
 		log.Println(err)
 	}
 
-	return a.R.SetUser(&models.GetUserResponse{ID: res.Id, Username: res.Username, Age: res.Age, Email: res.Email, LogOut: res.Logout})
+	return id, a.R.SetUser(&models.GetUserResponse{ID: res.Id, Username: res.Username, Age: res.Age, Email: res.Email, LogOut: res.Logout})
 }
 
-func (a *Adjust) DeleteUser(req *models.GetUserRequest) error {
-	data, err := json.Marshal(req)
+func (a *Adjust) DeleteUser(req *models.GetUserRequest, caller int32, idempotencyKey string) (string, error) {
+	return a.command("delete", req, caller, idempotencyKey)
+}
+
+// Logout ends the session the request was made with. When an admin logs
+// out someone else, all of that user's sessions end.
+func (a *Adjust) Logout(req *models.GetUserRequest, claims *token.Claims, idempotencyKey string) (string, error) {
+	var err error
+	if claims.UserID == req.ID {
+		err = a.R.RevokeSession(claims.SessionID, token.AccessTTL)
+	} else {
+		err = a.R.RevokeUser(req.ID, token.AccessTTL)
+	}
 	if err != nil {
 		log.Println(err)
-		return err
+		return "", err
 	}
-	return producer.Producer("delete", data)
+	return a.command("logout", req, claims.UserID, idempotencyKey)
 }
 
-func (a *Adjust) Logout(req *models.GetUserRequest) error {
-	data, err := json.Marshal(req)
+// LogoutAll ends every session of a user, on all devices.
+func (a *Adjust) LogoutAll(req *models.GetUserRequest, idempotencyKey string) (string, error) {
+	if err := a.R.RevokeUser(req.ID, token.AccessTTL); err != nil {
+		log.Println(err)
+		return "", err
+	}
+	return a.command("logout", req, req.ID, idempotencyKey)
+}
+
+// GetCommandStatus looks the command up in the services that consume
+// commands. Each of them answers NotFound for ids it does not know.
+func (a *Adjust) GetCommandStatus(id string) (*models.CommandStatus, error) {
+	if res, err := a.B.GetCommandStatus(a.Ctx, &booking.GetCommandStatusRequest{Id: id}); err == nil {
+		return &models.CommandStatus{
+			ID:        res.Id,
+			Kind:      res.Kind,
+			UserID:    res.UserId,
+			Status:    res.Status,
+			EntityID:  res.EntityId,
+			Message:   res.Message,
+			Error:     res.Error,
+			CreatedAt: res.CreatedAt.AsTime(),
+			UpdatedAt: res.UpdatedAt.AsTime(),
+		}, nil
+	} else if status.Code(err) != codes.NotFound {
+		log.Println(err)
+		return nil, err
+	}
+
+	res, err := a.U.GetCommandStatus(a.Ctx, &user.GetCommandStatusRequest2{Id: id})
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, models.ErrCommandNotFound
+		}
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return producer.Producer("logout", data)
+	return &models.CommandStatus{
+		ID:        res.Id,
+		Kind:      res.Kind,
+		UserID:    res.UserId,
+		Status:    res.Status,
+		EntityID:  res.EntityId,
+		Message:   res.Message,
+		Error:     res.Error,
+		CreatedAt: res.CreatedAt.AsTime(),
+		UpdatedAt: res.UpdatedAt.AsTime(),
+	}, nil
 }
 
 func (a *Adjust) CreateHotel(req *models.CreateHotelRequest) error {
-	_, err := a.H.CreateHotel(a.Ctx, &hotel.CreateHotelRequest{Name: req.Name, Location: req.Location, Rating: req.Rating, Address: req.Address})
+	_, err := a.H.CreateHotel(a.Ctx, &hotel.CreateHotelRequest{Name: req.Name, Location: req.Location, Rating: req.Rating, Address: req.Address, Latitude: req.Latitude, Longitude: req.Longitude})
 	return err
 }
 
@@ -170,10 +487,12 @@ func (a *Adjust) GetHotel(req *models.GetHotelRequest) (*models.GetHotelResponse
 			PricePerNight: v.PricePerNight,
 			ID:            v.Id,
 			HotelID:       v.HotelId,
+			Capacity:      v.Capacity,
+			Amenities:     v.Amenities,
 		})
 	}
 
-	return &models.GetHotelResponse{ID: req.ID, Name: res.Name, Location: res.Location, Rating: res.Rating, Address: res.Address, Rooms: rooms}, nil
+	return &models.GetHotelResponse{ID: req.ID, Name: res.Name, Location: res.Location, Rating: res.Rating, Address: res.Address, Rooms: rooms, Latitude: res.Latitude, Longitude: res.Longitude, Amenities: res.Amenities}, nil
 }
 
 func (a *Adjust) GetHotels(req *models.GetsRequest) ([]*models.UpdateHotelRequest, error) {
@@ -185,37 +504,208 @@ func (a *Adjust) GetHotels(req *models.GetsRequest) ([]*models.UpdateHotelReques
 	var hotels []*models.UpdateHotelRequest
 	for _, v := range res.Hotels {
 		hotels = append(hotels, &models.UpdateHotelRequest{
-			ID:       v.Id,
-			Name:     v.Name,
-			Location: v.Location,
-			Rating:   v.Rating,
-			Address:  v.Address,
+			ID:        v.Id,
+			Name:      v.Name,
+			Location:  v.Location,
+			Rating:    v.Rating,
+			Address:   v.Address,
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
 		})
 	}
 	return hotels, nil
 }
 
+func (a *Adjust) NearbyHotels(req *models.NearbyRequest) (*models.NearbyResponse, error) {
+	res, err := a.H.NearbyHotels(a.Ctx, &hotel.NearbyHotelsRequest{Latitude: req.Latitude, Longitude: req.Longitude, RadiusKm: req.RadiusKm, Limit: req.Limit})
+	if err != nil {
+		log.Println(err)
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", models.ErrInvalidSearch, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+
+	out := &models.NearbyResponse{Hotels: []*models.NearbyHotel{}}
+	for _, v := range res.Hotels {
+		out.Hotels = append(out.Hotels, &models.NearbyHotel{
+			ID:         v.Id,
+			Name:       v.Name,
+			Location:   v.Location,
+			Rating:     v.Rating,
+			Address:    v.Address,
+			Latitude:   v.Latitude,
+			Longitude:  v.Longitude,
+			DistanceKm: v.DistanceKm,
+		})
+	}
+	return out, nil
+}
+
+func (a *Adjust) CreateAmenity(req *models.Amenity) (*models.GeneralResponse, error) {
+	res, err := a.H.CreateAmenity(a.Ctx, &hotel.Amenity{Code: req.Code, Name: req.Name, Scope: req.Scope})
+	if err != nil {
+		return nil, amenityError(err)
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) ListAmenities(scope string) ([]*models.Amenity, error) {
+	res, err := a.H.ListAmenities(a.Ctx, &hotel.ListAmenitiesRequest{Scope: scope})
+	if err != nil {
+		return nil, amenityError(err)
+	}
+	amenities := []*models.Amenity{}
+	for _, v := range res.Amenities {
+		amenities = append(amenities, &models.Amenity{ID: v.Id, Code: v.Code, Name: v.Name, Scope: v.Scope})
+	}
+	return amenities, nil
+}
+
+func (a *Adjust) SetHotelAmenities(req *models.SetAmenitiesRequest) error {
+	_, err := a.H.SetHotelAmenities(a.Ctx, &hotel.SetAmenitiesRequest{HotelId: req.HotelID, Amenities: req.Amenities})
+	return amenityError(err)
+}
+
+func (a *Adjust) SetRoomAmenities(req *models.SetAmenitiesRequest) error {
+	_, err := a.H.SetRoomAmenities(a.Ctx, &hotel.SetAmenitiesRequest{HotelId: req.HotelID, RoomId: req.RoomID, Amenities: req.Amenities})
+	return amenityError(err)
+}
+
+// amenityError turns InvalidArgument from the hotel service into
+// models.ErrInvalidAmenity and returns any other error unchanged.
+func amenityError(err error) error {
+	return invalidArgument(err, models.ErrInvalidAmenity)
+}
+
+// invalidArgument turns InvalidArgument from the hotel service into target
+// and returns any other error unchanged.
+func invalidArgument(err, target error) error {
+	return statusError(err, codes.InvalidArgument, target)
+}
+
+// statusError wraps the message of a gRPC error with the given code in
+// target so handlers can match it with errors.Is.
+func statusError(err error, code codes.Code, target error) error {
+	if err == nil {
+		return nil
+	}
+	log.Println(err)
+	if status.Code(err) == code {
+		return fmt.Errorf("%w: %s", target, status.Convert(err).Message())
+	}
+	return err
+}
+
 func (a *Adjust) UpdateHotel(req *models.UpdateHotelRequest) error {
-	_, err := a.H.Update(a.Ctx, &hotel.UpdateHotelRequest{Id: req.ID, Name: req.Name, Location: req.Location, Rating: req.Rating, Address: req.Address})
+	_, err := a.H.Update(a.Ctx, &hotel.UpdateHotelRequest{Id: req.ID, Name: req.Name, Location: req.Location, Rating: req.Rating, Address: req.Address, Latitude: req.Latitude, Longitude: req.Longitude})
 	return err
 }
 
 func (a *Adjust) DeleteHotel(req *models.GetHotelRequest) error {
-	_, err := a.H.Delete(a.Ctx, &hotel.GetHotelRequest{Id: req.ID})
+	_, err := a.H.Delte(a.Ctx, &hotel.GetHotelRequest{Id: req.ID})
 	return err
 }
 
-func (a *Adjust) CreateRoom(req *models.CreateRoomRequest) error {
-	_, err := a.H.CreateRoom(a.Ctx, &hotel.CreateRoomRequest{HotelId: req.HotelID, RoomType: req.RoomType, PricePerNight: req.PricePerNight})
+func (a *Adjust) SetCancellationPolicy(req *models.CancellationPolicy) error {
+	_, err := a.H.SetCancellationPolicy(a.Ctx, &hotel.CancellationPolicy{HotelId: req.HotelID, FreeCancellationHours: req.FreeCancellationHours, PenaltyNights: req.PenaltyNights})
+	return err
+}
+
+func (a *Adjust) GetCancellationPolicy(req *models.GetHotelRequest) (*models.CancellationPolicy, error) {
+	res, err := a.H.GetCancellationPolicy(a.Ctx, &hotel.GetHotelRequest{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &models.CancellationPolicy{HotelID: res.HotelId, FreeCancellationHours: res.FreeCancellationHours, PenaltyNights: res.PenaltyNights}, nil
+}
+
+func (a *Adjust) CreateRate(req *models.Rate) (*models.GeneralResponse, error) {
+	res, err := a.H.CreateRate(a.Ctx, &hotel.Rate{
+		HotelId:      req.HotelID,
+		RoomType:     req.RoomType,
+		StartDate:    timestamppb.New(req.StartDate),
+		EndDate:      timestamppb.New(req.EndDate),
+		WeekdayPrice: req.WeekdayPrice,
+		WeekendPrice: req.WeekendPrice,
+		Priority:     req.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) DeleteRate(req *models.DeleteRateRequest) error {
+	_, err := a.H.DeleteRate(a.Ctx, &hotel.DeleteRateRequest{HotelId: req.HotelID, Id: req.ID})
 	return err
 }
 
+func (a *Adjust) CreateStayDiscount(req *models.StayDiscount) (*models.GeneralResponse, error) {
+	res, err := a.H.CreateStayDiscount(a.Ctx, &hotel.StayDiscount{HotelId: req.HotelID, RoomType: req.RoomType, MinNights: req.MinNights, Percent: req.Percent})
+	if err != nil {
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) DeleteStayDiscount(req *models.DeleteRateRequest) error {
+	_, err := a.H.DeleteStayDiscount(a.Ctx, &hotel.DeleteRateRequest{HotelId: req.HotelID, Id: req.ID})
+	return err
+}
+
+func (a *Adjust) GetRatePlan(req *models.RatePlanRequest) (*models.RatePlan, error) {
+	res, err := a.H.GetRatePlan(a.Ctx, &hotel.RatePlanRequest{HotelId: req.HotelID, RoomType: req.RoomType})
+	if err != nil {
+		return nil, err
+	}
+	var plan models.RatePlan
+	for _, v := range res.Rates {
+		plan.Rates = append(plan.Rates, &models.Rate{
+			ID:           v.Id,
+			HotelID:      v.HotelId,
+			RoomType:     v.RoomType,
+			StartDate:    v.StartDate.AsTime(),
+			EndDate:      v.EndDate.AsTime(),
+			WeekdayPrice: v.WeekdayPrice,
+			WeekendPrice: v.WeekendPrice,
+			Priority:     v.Priority,
+		})
+	}
+	for _, v := range res.Discounts {
+		plan.Discounts = append(plan.Discounts, &models.StayDiscount{ID: v.Id, HotelID: v.HotelId, RoomType: v.RoomType, MinNights: v.MinNights, Percent: v.Percent})
+	}
+	return &plan, nil
+}
+
+func (a *Adjust) Quote(req *models.QuoteRequest) (*models.Quote, error) {
+	res, err := a.H.Quote(a.Ctx, &hotel.QuoteRequest{
+		HotelId:      req.HotelID,
+		RoomId:       req.RoomID,
+		CheckInDate:  timestamppb.New(req.CheckInDate),
+		CheckOutDate: timestamppb.New(req.CheckOutDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	quote := &models.Quote{RoomID: res.RoomId, Subtotal: res.Subtotal, Discount: res.Discount, Total: res.Total}
+	for _, v := range res.Nights {
+		quote.Nights = append(quote.Nights, &models.NightPrice{Date: v.Date.AsTime(), Price: v.Price})
+	}
+	return quote, nil
+}
+
+func (a *Adjust) CreateRoom(req *models.CreateRoomRequest) error {
+	_, err := a.H.CreateRoom(a.Ctx, &hotel.CreateRoomRequest{HotelId: req.HotelID, RoomType: req.RoomType, RoomTypeId: req.RoomTypeID, RoomNumber: req.RoomNumber, Floor: req.Floor})
+	return invalidArgument(err, models.ErrInvalidRoom)
+}
+
 func (a *Adjust) GetRoom(req *models.GetRoomRequest) (*models.UpdateRoomRequest, error) {
 	res, err := a.H.Get(a.Ctx, &hotel.GetroomRequest{HotelId: req.HotelID, Id: req.ID})
 	if err != nil {
 		return nil, err
 	}
-	return &models.UpdateRoomRequest{Available: res.Available, RoomType: res.RoomType, PricePerNight: res.PricePerNight, ID: res.Id, HotelID: res.HotelId}, nil
+	return room(res), nil
 }
 
 func (a *Adjust) GetRooms(req *models.GetRoomRequest) (*models.GetRoomResponse, error) {
@@ -226,20 +716,80 @@ func (a *Adjust) GetRooms(req *models.GetRoomRequest) (*models.GetRoomResponse,
 
 	var rooms []*models.UpdateRoomRequest
 	for _, v := range res.Rooms {
-		rooms = append(rooms, &models.UpdateRoomRequest{
-			ID:            v.Id,
-			HotelID:       v.HotelId,
-			Available:     v.Available,
-			RoomType:      v.RoomType,
-			PricePerNight: v.PricePerNight,
-		})
+		rooms = append(rooms, room(v))
 	}
 	return &models.GetRoomResponse{Rooms: rooms}, nil
 }
 
+func room(v *hotel.UpdateRoomRequest) *models.UpdateRoomRequest {
+	return &models.UpdateRoomRequest{
+		ID:            v.Id,
+		HotelID:       v.HotelId,
+		Available:     v.Available,
+		RoomType:      v.RoomType,
+		PricePerNight: v.PricePerNight,
+		Capacity:      v.Capacity,
+		Amenities:     v.Amenities,
+		RoomTypeID:    v.RoomTypeId,
+		RoomNumber:    v.RoomNumber,
+		Floor:         v.Floor,
+	}
+}
+
 func (a *Adjust) UpdateRoom(req *models.UpdateRoomRequest) error {
-	_, err := a.H.UpdateRoom(a.Ctx, &hotel.UpdateRoomRequest{Available: req.Available, RoomType: req.RoomType, PricePerNight: req.PricePerNight, Id: req.ID, HotelId: req.HotelID})
-	return err
+	_, err := a.H.UpdateRoom(a.Ctx, &hotel.UpdateRoomRequest{Available: req.Available, Id: req.ID, HotelId: req.HotelID, RoomTypeId: req.RoomTypeID, RoomNumber: req.RoomNumber, Floor: req.Floor})
+	return invalidArgument(err, models.ErrInvalidRoom)
+}
+
+func (a *Adjust) CreateRoomType(req *models.RoomType) (*models.GeneralResponse, error) {
+	res, err := a.H.CreateRoomType(a.Ctx, roomType(req))
+	if err != nil {
+		return nil, invalidArgument(err, models.ErrInvalidRoomType)
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) GetRoomTypes(hotelID int32) ([]*models.RoomType, error) {
+	res, err := a.H.GetRoomTypes(a.Ctx, &hotel.GetHotelRequest{Id: hotelID})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	types := []*models.RoomType{}
+	for _, v := range res.RoomTypes {
+		types = append(types, &models.RoomType{
+			ID:               v.Id,
+			HotelID:          v.HotelId,
+			Name:             v.Name,
+			Capacity:         v.Capacity,
+			BedConfiguration: v.BedConfiguration,
+			BasePrice:        v.BasePrice,
+			Description:      v.Description,
+		})
+	}
+	return types, nil
+}
+
+func (a *Adjust) UpdateRoomType(req *models.RoomType) error {
+	_, err := a.H.UpdateRoomType(a.Ctx, roomType(req))
+	return invalidArgument(err, models.ErrInvalidRoomType)
+}
+
+func (a *Adjust) DeleteRoomType(hotelID, id int32) error {
+	_, err := a.H.DeleteRoomType(a.Ctx, &hotel.DeleteRateRequest{HotelId: hotelID, Id: id})
+	return invalidArgument(err, models.ErrInvalidRoomType)
+}
+
+func roomType(req *models.RoomType) *hotel.RoomType {
+	return &hotel.RoomType{
+		Id:               req.ID,
+		HotelId:          req.HotelID,
+		Name:             req.Name,
+		Capacity:         req.Capacity,
+		BedConfiguration: req.BedConfiguration,
+		BasePrice:        req.BasePrice,
+		Description:      req.Description,
+	}
 }
 
 func (a *Adjust) DeleteRoom(req *models.GetRoomRequest) error {
@@ -247,19 +797,19 @@ func (a *Adjust) DeleteRoom(req *models.GetRoomRequest) error {
 	return err
 }
 
-func (a *Adjust) CreateBooking(req *models.BookHotelRequest) (*models.GeneralResponse, error) {
+func (a *Adjust) CreateBooking(req *models.BookHotelRequest, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
-	res, err := a.B.Create(a.Ctx, &booking.Bytes{All: data})
+	res, err := a.B.Create(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GeneralResponse{Message: res.Message}, nil
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
 }
 
 func (a *Adjust) GetBooking(req *models.GetUsersBookRequest) (*models.GetUsersBookResponse, error) {
@@ -268,42 +818,192 @@ func (a *Adjust) GetBooking(req *models.GetUsersBookRequest) (*models.GetUsersBo
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GetUsersBookResponse{
-		ID:           res.Id,
-		UserID:       res.UserID,
-		HotelID:      res.HotelID,
-		RoomID:       res.RoomId,
-		RoomType:     res.RoomType,
-		CheckInDate:  res.CheckInDate.AsTime(),
-		CheckOutDate: res.CheckOutDate.AsTime(),
-		TotalAmount:  res.TotalAmount,
-		Status:       res.Status,
-	}, nil
+	out := bookingResponse(res)
+	for _, v := range res.Nights {
+		out.Nights = append(out.Nights, &models.NightPrice{Date: v.Date.AsTime(), Price: v.Price})
+	}
+	return out, nil
 }
 
-func (a *Adjust) UpdateBooking(req *models.BookHotelUpdateRequest) (*models.GeneralResponse, error) {
+// ListBookings returns a page of the bookings matching req.
+func (a *Adjust) ListBookings(req *models.ListRequest) (*models.BookingList, error) {
+	res, err := a.B.ListBookings(a.Ctx, listRequest(req))
+	if err != nil {
+		return nil, invalidArgument(err, models.ErrInvalidList)
+	}
+	out := &models.BookingList{Bookings: []*models.GetUsersBookResponse{}, NextCursor: res.NextCursor}
+	for _, v := range res.Bookings {
+		out.Bookings = append(out.Bookings, bookingResponse(v))
+	}
+	return out, nil
+}
+
+// ListWaitingList returns a page of the waiting list entries matching req.
+func (a *Adjust) ListWaitingList(req *models.ListRequest) (*models.WaitingList, error) {
+	res, err := a.B.ListWaitingList(a.Ctx, listRequest(req))
+	if err != nil {
+		return nil, invalidArgument(err, models.ErrInvalidList)
+	}
+	out := &models.WaitingList{Entries: []*models.GetWaitinglistResponse{}, NextCursor: res.NextCursor}
+	for _, v := range res.Entries {
+		out.Entries = append(out.Entries, waitingResponse(v))
+	}
+	return out, nil
+}
+
+func listRequest(req *models.ListRequest) *booking.ListRequest {
+	out := &booking.ListRequest{
+		UserId:  req.UserID,
+		HotelId: req.HotelID,
+		Status:  req.Status,
+		Sort:    req.Sort,
+		Cursor:  req.Cursor,
+		Limit:   req.Limit,
+	}
+	if !req.From.IsZero() {
+		out.From = timestamppb.New(req.From)
+	}
+	if !req.To.IsZero() {
+		out.To = timestamppb.New(req.To)
+	}
+	return out
+}
+
+// bookingResponse converts a booking without its nights.
+func bookingResponse(res *booking.GetUsersBookResponse) *models.GetUsersBookResponse {
+	out := &models.GetUsersBookResponse{
+		ID:             res.Id,
+		UserID:         res.UserID,
+		HotelID:        res.HotelID,
+		RoomID:         res.RoomId,
+		RoomType:       res.RoomType,
+		CheckInDate:    res.CheckInDate.AsTime(),
+		CheckOutDate:   res.CheckOutDate.AsTime(),
+		TotalAmount:    res.TotalAmount,
+		Status:         res.Status,
+		CancelReason:   res.CancelReason,
+		RefundAmount:   res.RefundAmount,
+		PenaltyAmount:  res.PenaltyAmount,
+		Discount:       res.Discount,
+		PreferredFloor: res.PreferredFloor,
+		RoomAmenities:  res.RoomAmenities,
+	}
+	if res.CancelledAt != nil {
+		cancelledAt := res.CancelledAt.AsTime()
+		out.CancelledAt = &cancelledAt
+	}
+	return out
+}
+
+func (a *Adjust) UpdateBooking(req *models.BookHotelUpdateRequest, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
-	res, err := a.B.Update(a.Ctx, &booking.Bytes{All: data})
+	res, err := a.B.Update(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GeneralResponse{Message: res.Message}, nil
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
 }
 
-func (a *Adjust) DeleteBooking(req *models.CancelRoomRequest) (*models.GeneralResponse, error) {
+func (a *Adjust) DeleteBooking(req *models.CancelRoomRequest, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
-	res, err := a.B.Delete(a.Ctx, &booking.Bytes{All: data})
+	res, err := a.B.Delete(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
+}
+
+func (a *Adjust) CheckAvailability(req *models.CheckAvailabilityRequest) (*models.CheckAvailabilityResponse, error) {
+	res, err := a.B.CheckAvailability(a.Ctx, &booking.CheckAvailabilityRequest{
+		HotelId:      req.HotelID,
+		RoomType:     req.RoomType,
+		CheckInDate:  timestamppb.New(req.CheckInDate),
+		CheckOutDate: timestamppb.New(req.CheckOutDate),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	rooms := []*models.FreeRoom{}
+	for _, v := range res.Rooms {
+		rooms = append(rooms, &models.FreeRoom{
+			ID:            v.Id,
+			HotelID:       v.HotelId,
+			RoomType:      v.RoomType,
+			PricePerNight: v.PricePerNight,
+		})
+	}
+	return &models.CheckAvailabilityResponse{Rooms: rooms}, nil
+}
+
+func (a *Adjust) SearchAvailability(req *models.SearchRequest) (*models.SearchResponse, error) {
+	res, err := a.B.SearchAvailability(a.Ctx, &booking.SearchAvailabilityRequest{
+		Location:     req.Location,
+		RoomType:     req.RoomType,
+		Guests:       req.Guests,
+		MinRating:    req.MinRating,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+		CheckInDate:  timestamppb.New(req.CheckInDate),
+		CheckOutDate: timestamppb.New(req.CheckOutDate),
+		Sort:         req.Sort,
+		Page:         req.Page,
+		PageSize:     req.PageSize,
+		Amenities:    req.Amenities,
+	})
+	if err != nil {
+		log.Println(err)
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", models.ErrInvalidSearch, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+
+	out := &models.SearchResponse{
+		Hotels:         []*models.AvailableHotel{},
+		Total:          res.Total,
+		Page:           res.Page,
+		PageSize:       res.PageSize,
+		AmenityFacets:  facets(res.AmenityFacets),
+		RoomTypeFacets: facets(res.RoomTypeFacets),
+	}
+	for _, v := range res.Hotels {
+		out.Hotels = append(out.Hotels, &models.AvailableHotel{
+			ID:          v.Id,
+			Name:        v.Name,
+			Location:    v.Location,
+			Rating:      v.Rating,
+			Address:     v.Address,
+			LowestPrice: v.LowestPrice,
+			FreeRooms:   v.FreeRooms,
+		})
+	}
+	return out, nil
+}
+
+func facets(in []*booking.Facet) []*models.Facet {
+	out := []*models.Facet{}
+	for _, v := range in {
+		out = append(out, &models.Facet{Value: v.Value, Count: v.Count})
+	}
+	return out
+}
+
+func (a *Adjust) CheckIn(req *models.GetUsersBookRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.CheckIn(a.Ctx, &booking.GetUsersBookRequest{Id: req.ID})
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -311,14 +1011,106 @@ func (a *Adjust) DeleteBooking(req *models.CancelRoomRequest) (*models.GeneralRe
 	return &models.GeneralResponse{Message: res.Message}, nil
 }
 
-func (a *Adjust) CreateWaitinglist(req *models.CreateWaitingList) (*models.GeneralResponse, error) {
-	data, err := json.Marshal(req)
+func (a *Adjust) AssignRoom(req *models.AssignRoomRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.AssignRoom(a.Ctx, &booking.AssignRoomRequest{Id: req.ID, RoomId: req.RoomID})
+	if err != nil {
+		return nil, statusError(err, codes.FailedPrecondition, models.ErrRoomAssign)
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) ReassignRoom(req *models.AssignRoomRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.ReassignRoom(a.Ctx, &booking.AssignRoomRequest{Id: req.ID, RoomId: req.RoomID})
+	if err != nil {
+		return nil, statusError(err, codes.FailedPrecondition, models.ErrRoomAssign)
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
+
+func (a *Adjust) GetOccupancyCalendar(hotelID int32, from, to time.Time) (*models.OccupancyCalendar, error) {
+	res, err := a.B.GetOccupancyCalendar(a.Ctx, &booking.GetOccupancyCalendarRequest{HotelId: hotelID, From: timestamppb.New(from), To: timestamppb.New(to)})
+	if status.Code(err) == codes.NotFound {
+		log.Println(err)
+		return nil, models.ErrHotelNotFound
+	}
+	if err != nil {
+		return nil, statusError(err, codes.InvalidArgument, models.ErrInvalidRange)
+	}
+	out := &models.OccupancyCalendar{HotelID: res.HotelId}
+	for _, v := range res.Dates {
+		out.Dates = append(out.Dates, v.AsTime())
+	}
+	for _, v := range res.Rooms {
+		out.Rooms = append(out.Rooms, &models.CalendarRoom{
+			RoomID:     v.RoomId,
+			RoomNumber: v.RoomNumber,
+			RoomType:   v.RoomType,
+			Floor:      v.Floor,
+			BookingIDs: v.BookingIds,
+		})
+	}
+	for _, v := range res.Bookings {
+		out.Bookings = append(out.Bookings, &models.CalendarBooking{
+			ID:           v.Id,
+			UserID:       v.UserId,
+			RoomID:       v.RoomId,
+			RoomType:     v.RoomType,
+			CheckInDate:  v.CheckInDate.AsTime(),
+			CheckOutDate: v.CheckOutDate.AsTime(),
+			Status:       v.Status,
+		})
+	}
+	return out, nil
+}
+
+func (a *Adjust) GetReport(req *models.ReportRequest) (*models.Report, error) {
+	res, err := a.B.GetReport(a.Ctx, &booking.ReportRequest{
+		HotelId:    req.HotelID,
+		From:       timestamppb.New(req.From),
+		To:         timestamppb.New(req.To),
+		Period:     req.Period,
+		ByRoomType: req.ByRoomType,
+	})
+	if status.Code(err) == codes.NotFound {
+		log.Println(err)
+		return nil, models.ErrHotelNotFound
+	}
+	if err != nil {
+		return nil, statusError(err, codes.InvalidArgument, models.ErrInvalidReport)
+	}
+	out := &models.Report{HotelID: res.HotelId, Period: res.Period}
+	for _, v := range res.Rows {
+		out.Rows = append(out.Rows, &models.ReportRow{
+			PeriodStart:         v.PeriodStart.AsTime(),
+			PeriodEnd:           v.PeriodEnd.AsTime(),
+			RoomType:            v.RoomType,
+			Rooms:               v.Rooms,
+			RoomNightsAvailable: v.RoomNightsAvailable,
+			RoomNightsSold:      v.RoomNightsSold,
+			OccupancyRate:       v.OccupancyRate,
+			Revenue:             v.Revenue,
+			ADR:                 v.Adr,
+			RevPAR:              v.Revpar,
+			Arrivals:            v.Arrivals,
+			Cancellations:       v.Cancellations,
+			CancellationRate:    v.CancellationRate,
+			AvgLeadTimeDays:     v.AvgLeadTimeDays,
+		})
+	}
+	return out, nil
+}
+
+func (a *Adjust) CheckOut(req *models.GetUsersBookRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.CheckOut(a.Ctx, &booking.GetUsersBookRequest{Id: req.ID})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	return &models.GeneralResponse{Message: res.Message}, nil
+}
 
-	res, err := a.B.CreateWaiting(a.Ctx, &booking.Bytes{All: data})
+func (a *Adjust) MarkNoShow(req *models.GetUsersBookRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.MarkNoShow(a.Ctx, &booking.GetUsersBookRequest{Id: req.ID})
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -326,13 +1118,61 @@ func (a *Adjust) CreateWaitinglist(req *models.CreateWaitingList) (*models.Gener
 	return &models.GeneralResponse{Message: res.Message}, nil
 }
 
+func (a *Adjust) GetPayment(req *models.GetUsersBookRequest) (*models.Payment, error) {
+	res, err := a.B.GetPayment(a.Ctx, &booking.GetUsersBookRequest{Id: req.ID})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	payment := &models.Payment{
+		ID:             res.Id,
+		BookingID:      res.BookingId,
+		Provider:       res.Provider,
+		Status:         res.Status,
+		Amount:         res.Amount,
+		CapturedAmount: res.CapturedAmount,
+		RefundedAmount: res.RefundedAmount,
+		Events:         []*models.PaymentEvent{},
+	}
+	for _, v := range res.Events {
+		payment.Events = append(payment.Events, &models.PaymentEvent{
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Amount:     v.Amount,
+			Error:      v.Error,
+			CreatedAt:  v.CreatedAt.AsTime(),
+		})
+	}
+	return payment, nil
+}
+
+func (a *Adjust) CreateWaitinglist(req *models.CreateWaitingList, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	res, err := a.B.CreateWaiting(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
+}
+
 func (a *Adjust) GetWaiting(req *models.GetWaitinglistRequest) (*models.GetWaitinglistResponse, error) {
 	res, err := a.B.GetWaitinglist(a.Ctx, &booking.GetWaitinglistRequest{Id: req.ID})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GetWaitinglistResponse{
+	return waitingResponse(res), nil
+}
+
+func waitingResponse(res *booking.GetWaitinglistResponse) *models.GetWaitinglistResponse {
+	out := &models.GetWaitinglistResponse{
 		UserID:       res.UserId,
 		UserEmail:    res.UserEmail,
 		RoomType:     res.RoomType,
@@ -341,35 +1181,50 @@ func (a *Adjust) GetWaiting(req *models.GetWaitinglistRequest) (*models.GetWaiti
 		CheckOutDate: res.CheckOutDate.AsTime(),
 		Status:       res.Status,
 		ID:           res.Id,
-	}, nil
+		BookingID:    res.BookingId,
+	}
+	if res.OfferExpiresAt != nil {
+		expiresAt := res.OfferExpiresAt.AsTime()
+		out.OfferExpiresAt = &expiresAt
+	}
+	return out
+}
+
+func (a *Adjust) AcceptOffer(req *models.AcceptOfferRequest) (*models.GeneralResponse, error) {
+	res, err := a.B.AcceptOffer(a.Ctx, &booking.AcceptOfferRequest{Id: req.ID, PaymentToken: req.PaymentToken})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: res.Message}, nil
 }
 
-func (a *Adjust) UpdateWaiting(req *models.UpdateWaitingListRequest) (*models.GeneralResponse, error) {
+func (a *Adjust) UpdateWaiting(req *models.UpdateWaitingListRequest, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
-	res, err := a.B.UpdateWaiting(a.Ctx, &booking.Bytes{All: data})
+	res, err := a.B.UpdateWaiting(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GeneralResponse{Message: res.Message}, nil
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
 }
 
-func (a *Adjust) DeleteWaiting(req *models.DeleteWaitingList) (*models.GeneralResponse, error) {
+func (a *Adjust) DeleteWaiting(req *models.DeleteWaitingList, caller int32, idempotencyKey string) (*models.GeneralResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
-	res, err := a.B.DeleteWaiting(a.Ctx, &booking.Bytes{All: data})
+	res, err := a.B.CancelWaiting(a.Ctx, &booking.Bytes{All: data, IdempotencyKey: idempotencyKey, UserId: caller})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &models.GeneralResponse{Message: res.Message}, nil
+	return &models.GeneralResponse{Message: res.Message, CommandID: res.CommandId}, nil
 }
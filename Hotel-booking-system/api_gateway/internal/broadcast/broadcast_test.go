@@ -0,0 +1,46 @@
+package broadcast1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginWait(t *testing.T) {
+	tests := []struct {
+		failures, free int64
+		want           time.Duration
+	}{
+		{0, loginFreeFailures, 0},
+		{loginFreeFailures, loginFreeFailures, 0},
+		{loginFreeFailures + 1, loginFreeFailures, time.Second},
+		{loginFreeFailures + 2, loginFreeFailures, 2 * time.Second},
+		{loginFreeFailures + 3, loginFreeFailures, 4 * time.Second},
+		{loginFreeFailures + 9, loginFreeFailures, 256 * time.Second},
+		{loginFreeFailures + 10, loginFreeFailures, loginMaxWait},
+		{loginFreeFailures + 1000, loginFreeFailures, loginMaxWait},
+		{loginIPFreeFailures, loginIPFreeFailures, 0},
+		{loginIPFreeFailures + 1, loginIPFreeFailures, time.Second},
+	}
+	for _, tt := range tests {
+		if got := loginWait(tt.failures, tt.free); got != tt.want {
+			t.Errorf("loginWait(%d, %d) = %v, want %v", tt.failures, tt.free, got, tt.want)
+		}
+	}
+}
+
+func TestLocksAccount(t *testing.T) {
+	tests := []struct {
+		failures int64
+		want     bool
+	}{
+		{1, false},
+		{loginLockFailures - 1, false},
+		{loginLockFailures, true},
+		{loginLockFailures + 1, false},
+	}
+	for _, tt := range tests {
+		if got := locksAccount(tt.failures); got != tt.want {
+			t.Errorf("locksAccount(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
@@ -15,7 +15,7 @@ import (
 
 // NewBroadcast initializes a new broadcast Adjust instance.
 func NewBroadcast() *broad.Adjust {
-	u := users.UserClient()
+	u := users.UserClinet()
 	h := hotels.Hotel()
 	b := booking.Hotel()
 	r := NewRedis()
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -14,6 +15,22 @@ type RegisterUserRequest struct {
 
 type GeneralResponse struct {
 	Message string `json:"message"`
+	// CommandID is set for asynchronous requests, see GET /commands/{id}.
+	CommandID string `json:"command_id,omitempty"`
+}
+
+// PendingSignup is a signup waiting for its emailed code, with the bcrypt
+// hash of the password in place of the password. It is also the create
+// command the user service gets once the code is verified.
+type PendingSignup struct {
+	Username     string `json:"username"`
+	Age          int32  `json:"age"`
+	Email        string `json:"email"`
+	PasswordHash string `json:"password_hash"`
+}
+
+type ResendCodeRequest struct {
+	Email string `json:"email"`
 }
 
 type VerifyRequest struct {
@@ -34,6 +51,84 @@ type LogInResponse struct {
 	Status bool `json:"status"`
 }
 
+// TokenResponse is what login and refresh return. ExpiresIn is the lifetime
+// of the access token in seconds; the refresh token may be used once.
+type TokenResponse struct {
+	UserID       int32  `json:"user_id"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordRequest sets a new password with the code emailed by
+// POST /users/password/forgot.
+type ResetPasswordRequest struct {
+	Email    string `json:"email"`
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// RetryError is an error that goes away after a wait, for Retry-After.
+type RetryError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%v, retry in %s", e.Err, e.After.Round(time.Second))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// AccountLock is an account unlocked after failed logins.
+type AccountLock struct {
+	ID    int32  `json:"id"`
+	Email string `json:"email"`
+}
+
+// Session is a login kept in Redis under the hash of its current refresh
+// token, with the claims its access tokens are issued with.
+type Session struct {
+	ID       string   `json:"id"`
+	UserID   int32    `json:"user_id"`
+	Email    string   `json:"email"`
+	Roles    []string `json:"roles"`
+	HotelIDs []int32  `json:"hotel_ids"`
+}
+
+// Roles a token may carry. Guests book, managers run the hotels assigned
+// to them and admins may do anything, including on the records of any user.
+const (
+	RoleGuest   = "guest"
+	RoleManager = "manager"
+	RoleAdmin   = "admin"
+)
+
+// RoleRequest grants or revokes a role. HotelID goes with the manager role:
+// granting assigns the hotel, revoking with it takes back just that hotel.
+type RoleRequest struct {
+	UserID  int32  `json:"-"`
+	Role    string `json:"role"`
+	HotelID int32  `json:"hotel_id"`
+}
+
+type UserRoles struct {
+	UserID   int32    `json:"user_id"`
+	Roles    []string `json:"roles"`
+	HotelIDs []int32  `json:"hotel_ids"`
+}
+
 type LastInsertedUser struct{}
 
 type GetUserResponse struct {
@@ -59,6 +154,14 @@ type BookHotelRequest struct {
 	RoomType     string    `json:"roomType"`
 	CheckInDate  time.Time `json:"checkInDate"`
 	CheckOutDate time.Time `json:"checkOutDate"`
+	PaymentToken string    `json:"payment_token"`
+	// AssignLater books RoomType without choosing a room; one is given a few
+	// days before arrival, by POST /bookings/{id}/assign-room or at check-in.
+	AssignLater bool `json:"assign_later"`
+	// PreferredFloor is tried first when the room is picked. Rooms with all
+	// of RoomAmenities (e.g. "accessible") are the only ones picked ahead of arrival.
+	PreferredFloor *int32   `json:"preferred_floor,omitempty"`
+	RoomAmenities  []string `json:"room_amenities,omitempty"`
 }
 
 type GetUsersBookRequest struct {
@@ -79,6 +182,15 @@ type GetUsersBookResponse struct {
 	CheckOutDate time.Time `json:"checkOutDate"`
 	TotalAmount  float32   `json:"totalAmount"`
 	Status       string    `json:"status"`
+	// CancelledAt is empty unless the booking was cancelled.
+	CancelledAt    *time.Time    `json:"cancelledAt,omitempty"`
+	CancelReason   string        `json:"cancelReason,omitempty"`
+	RefundAmount   float32       `json:"refundAmount"`
+	PenaltyAmount  float32       `json:"penaltyAmount"`
+	Discount       float32       `json:"discount"`
+	Nights         []*NightPrice `json:"nights"`
+	PreferredFloor *int32        `json:"preferred_floor,omitempty"`
+	RoomAmenities  []string      `json:"room_amenities,omitempty"`
 }
 
 type BookHotelUpdateRequest struct {
@@ -87,10 +199,12 @@ type BookHotelUpdateRequest struct {
 	RoomType     string    `json:"roomType"`
 	CheckInDate  time.Time `json:"checkInDate"`
 	CheckOutDate time.Time `json:"checkOutDate"`
+	PaymentToken string    `json:"payment_token"`
 }
 
 type CancelRoomRequest struct {
-	ID int32 `json:"id"`
+	ID     int32  `json:"id"`
+	Reason string `json:"reason"`
 }
 
 type CreateWaitingList struct {
@@ -115,6 +229,14 @@ type GetWaitinglistResponse struct {
 	CheckOutDate time.Time `json:"checkOutDate"`
 	Status       string    `json:"status"`
 	ID           int32     `json:"id"`
+	// BookingID and OfferExpiresAt are set once the entry is offered a room.
+	BookingID      int32      `json:"booking_id,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+}
+
+type AcceptOfferRequest struct {
+	ID           int32  `json:"-"`
+	PaymentToken string `json:"payment_token"`
 }
 
 type UpdateWaitingListRequest struct {
@@ -130,40 +252,156 @@ type DeleteWaitingList struct {
 	ID int32 `json:"id"`
 }
 
+type CheckAvailabilityRequest struct {
+	HotelID      int32     `json:"hotel_id"`
+	RoomType     string    `json:"room_type"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+}
+
+type FreeRoom struct {
+	ID            int32   `json:"id"`
+	HotelID       int32   `json:"hotel_id"`
+	RoomType      string  `json:"room_type"`
+	PricePerNight float32 `json:"price_per_night"`
+}
+
+type CheckAvailabilityResponse struct {
+	Rooms []*FreeRoom `json:"rooms"`
+}
+
+type PaymentEvent struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Amount     float32   `json:"amount"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Payment struct {
+	ID             int32           `json:"id"`
+	BookingID      int32           `json:"booking_id"`
+	Provider       string          `json:"provider"`
+	Status         string          `json:"status"`
+	Amount         float32         `json:"amount"`
+	CapturedAmount float32         `json:"captured_amount"`
+	RefundedAmount float32         `json:"refunded_amount"`
+	Events         []*PaymentEvent `json:"events"`
+}
+
 type CreateHotelRequest struct {
 	Name     string `json:"name"`
 	Location string `json:"location"`
 	Rating   int32  `json:"rating"`
 	Address  string `json:"address"`
+	// Latitude and Longitude are in degrees and go together; hotels
+	// without them are not found by /hotels/nearby.
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 type GetsRequest struct {
 }
 
 type UpdateHotelRequest struct {
-	ID       int32  `json:"id"`
-	Name     string `json:"name"`
-	Location string `json:"location"`
-	Rating   int32  `json:"rating"`
-	Address  string `json:"address"`
+	ID        int32    `json:"id"`
+	Name      string   `json:"name"`
+	Location  string   `json:"location"`
+	Rating    int32    `json:"rating"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 
 type GetHotelRequest struct {
 	ID int32 `json:"id"`
 }
 
+// Rate overrides the price of a room type, or of every room type when
+// RoomType is empty, for nights in [StartDate, EndDate). The rate with the
+// higher Priority wins where rates overlap.
+type Rate struct {
+	ID           int32     `json:"id"`
+	HotelID      int32     `json:"hotel_id"`
+	RoomType     string    `json:"room_type"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	WeekdayPrice float32   `json:"weekday_price"`
+	WeekendPrice float32   `json:"weekend_price"`
+	Priority     int32     `json:"priority"`
+}
+
+// StayDiscount takes Percent off stays of at least MinNights nights.
+type StayDiscount struct {
+	ID        int32   `json:"id"`
+	HotelID   int32   `json:"hotel_id"`
+	RoomType  string  `json:"room_type"`
+	MinNights int32   `json:"min_nights"`
+	Percent   float32 `json:"percent"`
+}
+
+type RatePlanRequest struct {
+	HotelID  int32  `json:"hotel_id"`
+	RoomType string `json:"room_type"`
+}
+
+type RatePlan struct {
+	Rates     []*Rate         `json:"rates"`
+	Discounts []*StayDiscount `json:"discounts"`
+}
+
+type DeleteRateRequest struct {
+	HotelID int32 `json:"hotel_id"`
+	ID      int32 `json:"id"`
+}
+
+type QuoteRequest struct {
+	HotelID      int32     `json:"hotel_id"`
+	RoomID       int32     `json:"room_id"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+}
+
+type NightPrice struct {
+	Date  time.Time `json:"date"`
+	Price float32   `json:"price"`
+}
+
+type Quote struct {
+	RoomID   int32         `json:"room_id"`
+	Nights   []*NightPrice `json:"nights"`
+	Subtotal float32       `json:"subtotal"`
+	Discount float32       `json:"discount"`
+	Total    float32       `json:"total"`
+}
+
+// CancellationPolicy lets guests cancel for free until FreeCancellationHours
+// before check-in; later cancellations are charged PenaltyNights nights.
+type CancellationPolicy struct {
+	HotelID               int32 `json:"hotel_id"`
+	FreeCancellationHours int32 `json:"free_cancellation_hours"`
+	PenaltyNights         int32 `json:"penalty_nights"`
+}
+
 type GetHotelResponse struct {
-	ID       int32                `json:"id"`
-	Name     string               `json:"name"`
-	Location string               `json:"location"`
-	Rating   int32                `json:"rating"`
-	Address  string               `json:"address"`
-	Rooms    []*UpdateRoomRequest `json:"rooms"`
+	ID        int32                `json:"id"`
+	Name      string               `json:"name"`
+	Location  string               `json:"location"`
+	Rating    int32                `json:"rating"`
+	Address   string               `json:"address"`
+	Rooms     []*UpdateRoomRequest `json:"rooms"`
+	Latitude  *float64             `json:"latitude"`
+	Longitude *float64             `json:"longitude"`
+	Amenities []string             `json:"amenities"`
 }
 
+// CreateRoomRequest adds a room of an existing room type, given by
+// room_type_id or by its name in room_type.
 type CreateRoomRequest struct {
-	HotelID       int32   `json:"hotel_id"`
-	RoomType      string  `json:"room_type"`
-	PricePerNight float32 `json:"price_per_night"`
+	HotelID    int32  `json:"hotel_id"`
+	RoomType   string `json:"room_type"`
+	RoomTypeID int32  `json:"room_type_id"`
+	RoomNumber string `json:"room_number"`
+	Floor      int32  `json:"floor"`
 }
 
 type GetRoomRequest struct {
@@ -185,10 +423,263 @@ type UpdateRoomRequest struct {
 	PricePerNight float32 `json:"price_per_night"`
 	ID            int32   `json:"id"`
 	HotelID       int32   `json:"hotel_id"`
+	Capacity      int32   `json:"capacity"`
+	// Amenities is returned with the room and ignored on update, use
+	// PUT /hotels/rooms/amenities/{id} to change it.
+	Amenities []string `json:"amenities"`
+	// RoomType, PricePerNight and Capacity come from the room type and are
+	// ignored on update; room_type_id moves the room to another type.
+	RoomTypeID int32  `json:"room_type_id"`
+	RoomNumber string `json:"room_number"`
+	// Floor is left unchanged when omitted on update.
+	Floor *int32 `json:"floor"`
+}
+
+// SearchRequest filters hotels for a stay. Empty strings and zero numbers
+// mean no filter.
+type SearchRequest struct {
+	Location     string    `json:"location"`
+	RoomType     string    `json:"room_type"`
+	Guests       int32     `json:"guests"`
+	MinRating    int32     `json:"min_rating"`
+	MinPrice     float32   `json:"min_price"`
+	MaxPrice     float32   `json:"max_price"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+	Sort         string    `json:"sort"`
+	Page         int32     `json:"page"`
+	PageSize     int32     `json:"page_size"`
+	// Amenities must all be offered by the hotel or the room itself.
+	Amenities []string `json:"amenities"`
+}
+
+// AvailableHotel is a hotel with at least one matching room free for the
+// whole stay. LowestPrice is the cheapest of those rooms per night.
+type AvailableHotel struct {
+	ID          int32   `json:"id"`
+	Name        string  `json:"name"`
+	Location    string  `json:"location"`
+	Rating      int32   `json:"rating"`
+	Address     string  `json:"address"`
+	LowestPrice float32 `json:"lowest_price"`
+	FreeRooms   int32   `json:"free_rooms"`
+}
+
+// Facet counts the hotels of a search result that have Value.
+type Facet struct {
+	Value string `json:"value"`
+	Count int32  `json:"count"`
+}
+
+// SearchResponse holds one page of hotels. The facets count every hotel
+// that matched, most common values first.
+type SearchResponse struct {
+	Hotels         []*AvailableHotel `json:"hotels"`
+	Total          int32             `json:"total"`
+	Page           int32             `json:"page"`
+	PageSize       int32             `json:"page_size"`
+	AmenityFacets  []*Facet          `json:"amenity_facets"`
+	RoomTypeFacets []*Facet          `json:"room_type_facets"`
+}
+
+// Amenity is an entry of the amenity catalog. Scope is "hotel" for
+// amenities of the whole hotel or "room" for those of a single room.
+type Amenity struct {
+	ID    int32  `json:"id"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Scope string `json:"scope"`
+}
+
+// SetAmenitiesRequest replaces the amenities of a hotel or a room.
+type SetAmenitiesRequest struct {
+	HotelID   int32    `json:"hotel_id"`
+	RoomID    int32    `json:"-"`
+	Amenities []string `json:"amenities"`
+}
+
+// RoomType is what a hotel sells: its rooms share capacity, beds and base
+// price. The name can't be changed, rates and bookings refer to it.
+type RoomType struct {
+	ID               int32   `json:"id"`
+	HotelID          int32   `json:"hotel_id"`
+	Name             string  `json:"name"`
+	Capacity         int32   `json:"capacity"`
+	BedConfiguration string  `json:"bed_configuration"`
+	BasePrice        float32 `json:"base_price"`
+	Description      string  `json:"description"`
+}
+
+// AssignRoomRequest gives a room to a booking made with assign_later.
+// Zero RoomID picks a free room of the booked type. To move a booking,
+// RoomID must be set.
+type AssignRoomRequest struct {
+	ID     int32 `json:"-"`
+	RoomID int32 `json:"room_id"`
+}
+
+// CalendarRoom is a row of the occupancy calendar. BookingIDs has one
+// entry per date of the calendar, 0 when the room is free that night.
+type CalendarRoom struct {
+	RoomID     int32   `json:"room_id"`
+	RoomNumber string  `json:"room_number"`
+	RoomType   string  `json:"room_type"`
+	Floor      int32   `json:"floor"`
+	BookingIDs []int32 `json:"booking_ids"`
+}
+
+// CalendarBooking is a booking shown on the calendar; RoomID is 0 while it
+// waits for a room.
+type CalendarBooking struct {
+	ID           int32     `json:"id"`
+	UserID       int32     `json:"user_id"`
+	RoomID       int32     `json:"room_id"`
+	RoomType     string    `json:"room_type"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+	Status       string    `json:"status"`
+}
+
+// OccupancyCalendar is the tape chart of a hotel: its rooms by the dates
+// of the calendar, and the bookings occupying them.
+type OccupancyCalendar struct {
+	HotelID  int32              `json:"hotel_id"`
+	Dates    []time.Time        `json:"dates"`
+	Rooms    []*CalendarRoom    `json:"rooms"`
+	Bookings []*CalendarBooking `json:"bookings"`
+}
+
+// ListRequest filters a list of bookings or waiting list entries; zero
+// values mean no filter. From and To keep the stays overlapping [From, To).
+type ListRequest struct {
+	UserID  int32     `json:"user_id"`
+	HotelID int32     `json:"hotel_id"`
+	Status  string    `json:"status"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Sort    string    `json:"sort"`
+	Cursor  string    `json:"cursor"`
+	Limit   int32     `json:"limit"`
+}
+
+// BookingList is a page of bookings. NextCursor asks for the next page and
+// is empty on the last one.
+type BookingList struct {
+	Bookings   []*GetUsersBookResponse `json:"bookings"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+type WaitingList struct {
+	Entries    []*GetWaitinglistResponse `json:"entries"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+}
+
+// ReportRequest asks for the figures of a hotel over [From, To), a row per
+// Period ("day", "week" or "month") and, with ByRoomType, per room type.
+type ReportRequest struct {
+	HotelID    int32     `json:"hotel_id"`
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
+	Period     string    `json:"period"`
+	ByRoomType bool      `json:"by_room_type"`
+}
+
+// ReportRow holds the figures of one period, for one room type or for the
+// whole hotel when RoomType is empty. Rates are fractions, 0.75 is 75%.
+type ReportRow struct {
+	PeriodStart         time.Time `json:"period_start"`
+	PeriodEnd           time.Time `json:"period_end"`
+	RoomType            string    `json:"room_type"`
+	Rooms               int32     `json:"rooms"`
+	RoomNightsAvailable int32     `json:"room_nights_available"`
+	RoomNightsSold      int32     `json:"room_nights_sold"`
+	OccupancyRate       float32   `json:"occupancy_rate"`
+	Revenue             float32   `json:"revenue"`
+	ADR                 float32   `json:"adr"`
+	RevPAR              float32   `json:"revpar"`
+	Arrivals            int32     `json:"arrivals"`
+	Cancellations       int32     `json:"cancellations"`
+	CancellationRate    float32   `json:"cancellation_rate"`
+	AvgLeadTimeDays     float32   `json:"avg_lead_time_days"`
+}
+
+type Report struct {
+	HotelID int32        `json:"hotel_id"`
+	Period  string       `json:"period"`
+	Rows    []*ReportRow `json:"rows"`
+}
+
+// NearbyRequest asks for hotels within RadiusKm of a point.
+type NearbyRequest struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+	Limit     int32   `json:"limit"`
+}
+
+type NearbyHotel struct {
+	ID         int32   `json:"id"`
+	Name       string  `json:"name"`
+	Location   string  `json:"location"`
+	Rating     int32   `json:"rating"`
+	Address    string  `json:"address"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// NearbyResponse lists the hotels nearest first.
+type NearbyResponse struct {
+	Hotels []*NearbyHotel `json:"hotels"`
+}
+
+type CommandStatus struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	UserID    int32     `json:"user_id"`
+	Status    string    `json:"status"`
+	EntityID  int32     `json:"entity_id"`
+	Message   string    `json:"message"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IdempotentResponse is what the gateway keeps for an Idempotency-Key: the
+// request it was first used with and, once that finished, its response.
+type IdempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
 }
 
 var (
 	ErrHotelNotFound    = errors.New("there is no such hotel with this id")
 	ErrRoomNotFound     = errors.New("no room found matching the given criteria")
 	ErrRoomNotAvailable = errors.New("room is not available for the requested dates")
+	ErrCommandNotFound  = errors.New("command not found or not processed yet")
+	ErrInvalidSearch    = errors.New("invalid search")
+	ErrInvalidAmenity   = errors.New("invalid amenity")
+	ErrInvalidRoom      = errors.New("invalid room")
+	ErrInvalidRoomType  = errors.New("invalid room type")
+	ErrRoomAssign       = errors.New("room can't be assigned")
+	ErrInvalidRange     = errors.New("invalid date range")
+	ErrInvalidReport    = errors.New("invalid report request")
+	ErrInvalidList      = errors.New("invalid list request")
+	ErrForbidden        = errors.New("you are not allowed to access this resource")
+	ErrInvalidRole      = errors.New("invalid role")
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidRefresh   = errors.New("refresh token is invalid, expired or already used")
+	ErrInvalidResetCode = errors.New("reset code is invalid or expired")
+	ErrTooManyAttempts  = errors.New("too many attempts, ask for a new code")
+	ErrCodeCooldown     = errors.New("a code was sent recently, wait before asking again")
+	ErrWeakPassword     = errors.New("password is too weak")
+	ErrInvalidCode      = errors.New("code is invalid or expired")
+	ErrSignupPending    = errors.New("a signup of this email is waiting for its code")
+	ErrSignupNotFound   = errors.New("no signup of this email is waiting for a code")
+	ErrBadCredentials   = errors.New("password or email doesn't match or is missing")
+	ErrAccountLocked    = errors.New("the account is locked after too many failed logins")
+	ErrLoginThrottled   = errors.New("too many failed logins, try again later")
 )
@@ -3,6 +3,7 @@ package redismethod
 import (
 	"api-gateway/models"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"log"
 	"strconv"
@@ -16,47 +17,134 @@ type Redis struct {
 	Ctx context.Context
 }
 
-func (u *Redis) Register(req *models.RegisterUserRequest) error {
+// Keys of the signups waiting for their code:
+//
+//	signup:<email>         hash of the signup data, code hash, attempts and resends
+//	signup-sent:<email>    set while a new code can't be sent yet
+//	signup-locked:<email>  set while the email can't sign up after too many wrong codes
+
+// StartSignup keeps a signup until its code is verified. It returns
+// models.ErrSignupPending while another signup of the email waits.
+func (u *Redis) StartSignup(req *models.PendingSignup, ttl, cooldown time.Duration) error {
+	locked, err := u.R.Exists(u.Ctx, "signup-locked:"+req.Email).Result()
+	if err != nil {
+		return err
+	}
+	if locked > 0 {
+		return models.ErrTooManyAttempts
+	}
 	byted, err := json.Marshal(req)
 	if err != nil {
 		log.Println(err)
 		return err
 	}
-	if err := u.R.Set(u.Ctx, req.Email, byted, time.Minute*1).Err(); err != nil {
-		log.Println(err)
+	keys := []string{"signup:" + req.Email, "signup-sent:" + req.Email}
+	ok, err := startSignup.Run(u.Ctx, u.R, keys, byted, ttl.Milliseconds(), cooldown.Milliseconds()).Bool()
+	if err != nil {
 		return err
 	}
+	if !ok {
+		return models.ErrSignupPending
+	}
 	return nil
 }
 
-func (u *Redis) RegisterGet(email string) (*models.RegisterUserRequest, error) {
-	val, err := u.R.Get(u.Ctx, email).Result()
+// startSignup stores the signup data together with its TTL, so a signup
+// can never be left without one and block its email for good.
+var startSignup = redis.NewScript(`
+if redis.call('HSETNX', KEYS[1], 'data', ARGV[1]) == 0 then
+	return 0
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+redis.call('SET', KEYS[2], 1, 'PX', ARGV[3])
+return 1
+`)
+
+// StartSignupResend allows a new code for a waiting signup once per
+// cooldown and at most maxResends times.
+func (u *Redis) StartSignupResend(email string, cooldown time.Duration, maxResends int64) error {
+	key := "signup:" + email
+	exists, err := u.R.Exists(u.Ctx, key).Result()
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return err
 	}
-	var res models.RegisterUserRequest
-
-	if err := json.Unmarshal([]byte(val), &res); err != nil {
-		log.Println(err)
-		return nil, err
+	if exists == 0 {
+		return models.ErrSignupNotFound
 	}
-	return &res, nil
-}
-
-func (u *Redis) VerifyCodeRequest(req *models.VerifyRequest) error {
-	if err := u.R.Set(u.Ctx, req.Email+"code", req.Code, time.Minute*5).Err(); err != nil {
+	ok, err := u.R.SetNX(u.Ctx, "signup-sent:"+email, 1, cooldown).Result()
+	if err != nil {
 		return err
 	}
+	if !ok {
+		return models.ErrCodeCooldown
+	}
+	resends, err := u.R.HIncrBy(u.Ctx, key, "resends", 1).Result()
+	if err != nil {
+		return err
+	}
+	if resends > maxResends {
+		return models.ErrTooManyAttempts
+	}
 	return nil
 }
 
-func (u *Redis) VerifyCodeResponse(req *models.VerifyRequest) (string, error) {
-	var code string
-	if err := u.R.Get(u.Ctx, req.Email+"code").Scan(&code); err != nil {
-		return "", nil
+// SaveSignupCode stores the hash of the code sent for a signup. The signup
+// lives as long as its code, ttl from now.
+func (u *Redis) SaveSignupCode(email, hash string, ttl time.Duration) error {
+	key := "signup:" + email
+	_, err := u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(u.Ctx, key, "code", hash)
+		pipe.Expire(u.Ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// UseSignupCode checks the code of a signup and returns the signup once,
+// when the code matches. After maxAttempts wrong codes, resent ones
+// included, the signup is dropped and the email locked out for lockout.
+func (u *Redis) UseSignupCode(email, hash string, maxAttempts int64, lockout time.Duration) (*models.PendingSignup, error) {
+	key := "signup:" + email
+	res, err := u.R.HMGet(u.Ctx, key, "data", "code").Result()
+	if err != nil {
+		return nil, err
+	}
+	data, _ := res[0].(string)
+	code, _ := res[1].(string)
+	if data == "" || code == "" {
+		return nil, models.ErrInvalidCode
+	}
+	attempts, err := u.R.HIncrBy(u.Ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if attempts > maxAttempts {
+		_, err := u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(u.Ctx, key)
+			pipe.Set(u.Ctx, "signup-locked:"+email, 1, lockout)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, models.ErrTooManyAttempts
+	}
+	if subtle.ConstantTimeCompare([]byte(code), []byte(hash)) != 1 {
+		return nil, models.ErrInvalidCode
+	}
+	deleted, err := u.R.Del(u.Ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, models.ErrInvalidCode
 	}
-	return code, nil
+	var signup models.PendingSignup
+	if err := json.Unmarshal([]byte(data), &signup); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &signup, nil
 }
 
 func (u *Redis) SetUser(req *models.GetUserResponse) error {
@@ -87,3 +175,268 @@ func (u *Redis) GetUser(req *models.GetUserRequest) (*models.GetUserResponse, er
 	}
 	return &res, nil
 }
+
+// ReserveIdempotencyKey stores req under key unless the key is already taken.
+// It reports whether the key was reserved.
+func (u *Redis) ReserveIdempotencyKey(key string, req *models.IdempotentResponse, ttl time.Duration) (bool, error) {
+	byted, err := json.Marshal(req)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	return u.R.SetNX(u.Ctx, key, byted, ttl).Result()
+}
+
+func (u *Redis) SaveIdempotencyKey(key string, req *models.IdempotentResponse, ttl time.Duration) error {
+	byted, err := json.Marshal(req)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return u.R.Set(u.Ctx, key, byted, ttl).Err()
+}
+
+// GetIdempotencyKey returns redis.Nil when the key is not stored.
+func (u *Redis) GetIdempotencyKey(key string) (*models.IdempotentResponse, error) {
+	var res models.IdempotentResponse
+
+	val, err := u.R.Get(u.Ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(val, &res); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *Redis) ReleaseIdempotencyKey(key string) error {
+	return u.R.Del(u.Ctx, key).Err()
+}
+
+// Keys of the sessions:
+//
+//	refresh:<hash>         the session a live refresh token belongs to
+//	refresh-used:<hash>    the session of a refresh token already rotated
+//	session:<id>           the hash of the live refresh token of a session
+//	user-sessions:<id>     the sessions of a user
+//	revoked-session:<id>   set while access tokens of the session may live
+//	revoked-before:<id>    unix time up to which a user's tokens are revoked
+
+// SaveSession stores the refresh token hash of a new or refreshed session.
+func (u *Redis) SaveSession(hash string, req *models.Session, ttl time.Duration) error {
+	byted, err := json.Marshal(req)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	userSessions := "user-sessions:" + strconv.Itoa(int(req.UserID))
+	_, err = u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(u.Ctx, "refresh:"+hash, byted, ttl)
+		pipe.Set(u.Ctx, "session:"+req.ID, hash, ttl)
+		pipe.SAdd(u.Ctx, userSessions, req.ID)
+		pipe.Expire(u.Ctx, userSessions, ttl)
+		return nil
+	})
+	return err
+}
+
+// TakeSession removes a refresh token and returns its session, so that a
+// token is only ever used once. It returns redis.Nil for unknown tokens.
+func (u *Redis) TakeSession(hash string) (*models.Session, error) {
+	val, err := u.R.GetDel(u.Ctx, "refresh:"+hash).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var res models.Session
+	if err := json.Unmarshal(val, &res); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+// MarkRefreshUsed remembers the session of a rotated refresh token.
+func (u *Redis) MarkRefreshUsed(hash, sessionID string, ttl time.Duration) error {
+	return u.R.Set(u.Ctx, "refresh-used:"+hash, sessionID, ttl).Err()
+}
+
+// UsedRefresh returns the session of a rotated refresh token, redis.Nil
+// when the token was never issued or has long expired.
+func (u *Redis) UsedRefresh(hash string) (string, error) {
+	return u.R.Get(u.Ctx, "refresh-used:"+hash).Result()
+}
+
+// RevokeSession deletes the refresh token of a session and revokes its
+// access tokens for accessTTL, the longest any of them can still live.
+func (u *Redis) RevokeSession(sessionID string, accessTTL time.Duration) error {
+	hash, err := u.R.GetDel(u.Ctx, "session:"+sessionID).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	_, err = u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		if hash != "" {
+			pipe.Del(u.Ctx, "refresh:"+hash)
+		}
+		pipe.Set(u.Ctx, "revoked-session:"+sessionID, 1, accessTTL)
+		return nil
+	})
+	return err
+}
+
+// RevokeUser ends every session of a user and revokes the access tokens
+// issued to them until now.
+func (u *Redis) RevokeUser(userID int32, accessTTL time.Duration) error {
+	id := strconv.Itoa(int(userID))
+	sessions, err := u.R.SMembers(u.Ctx, "user-sessions:"+id).Result()
+	if err != nil {
+		return err
+	}
+	for _, v := range sessions {
+		if err := u.RevokeSession(v, accessTTL); err != nil {
+			return err
+		}
+	}
+	_, err = u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(u.Ctx, "user-sessions:"+id)
+		pipe.Set(u.Ctx, "revoked-before:"+id, time.Now().UnixMilli(), accessTTL)
+		return nil
+	})
+	return err
+}
+
+// Revoked reports whether a session was ended or the user's tokens up to
+// issuedAt were revoked.
+func (u *Redis) Revoked(sessionID string, userID int32, issuedAt time.Time) (bool, error) {
+	res, err := u.R.MGet(u.Ctx, "revoked-session:"+sessionID, "revoked-before:"+strconv.Itoa(int(userID))).Result()
+	if err != nil {
+		return false, err
+	}
+	if res[0] != nil {
+		return true, nil
+	}
+	if v, ok := res[1].(string); ok {
+		before, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return false, err
+		}
+		// Compared in milliseconds, so a token issued right after the
+		// revocation, by the login that follows it, stays valid
+		return issuedAt.UnixMilli() <= before, nil
+	}
+	return false, nil
+}
+
+// StartResetCode allows one password reset code for email per cooldown and
+// returns models.ErrCodeCooldown until then.
+func (u *Redis) StartResetCode(email string, cooldown time.Duration) error {
+	ok, err := u.R.SetNX(u.Ctx, "password-reset-sent:"+email, 1, cooldown).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return models.ErrCodeCooldown
+	}
+	return nil
+}
+
+// SaveResetCode stores the hash of a password reset code for email,
+// replacing any earlier code.
+func (u *Redis) SaveResetCode(email, hash string, ttl time.Duration) error {
+	key := "password-reset:" + email
+	_, err := u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(u.Ctx, key)
+		pipe.HSet(u.Ctx, key, "hash", hash, "attempts", 0)
+		pipe.Expire(u.Ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// UseResetCode checks a password reset code and deletes it once it
+// matched, so each code works once. After maxAttempts wrong codes the code
+// is deleted and models.ErrTooManyAttempts returned.
+func (u *Redis) UseResetCode(email, hash string, maxAttempts int64) error {
+	key := "password-reset:" + email
+	stored, err := u.R.HGet(u.Ctx, key, "hash").Result()
+	if err == redis.Nil {
+		return models.ErrInvalidResetCode
+	}
+	if err != nil {
+		return err
+	}
+	// Every try counts, the right one too, so that guesses sent in
+	// parallel can't get past the limit
+	attempts, err := u.R.HIncrBy(u.Ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return err
+	}
+	if attempts > maxAttempts {
+		u.R.Del(u.Ctx, key)
+		return models.ErrTooManyAttempts
+	}
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) != 1 {
+		return models.ErrInvalidResetCode
+	}
+	// Only the request that deletes the code may use it
+	deleted, err := u.R.Del(u.Ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrInvalidResetCode
+	}
+	return nil
+}
+
+// Keys of the failed logins:
+//
+//	login-failures:<email>     failed logins of an account in the window
+//	login-failures-ip:<ip>     failed logins from an address in the window
+//	login-wait:<email>         set while the account has to wait to try again
+//	login-wait-ip:<ip>         set while the address has to wait to try again
+
+// LoginWait returns how long logins to email or from ip have to wait.
+func (u *Redis) LoginWait(email, ip string) (time.Duration, error) {
+	var account, address *redis.DurationCmd
+	_, err := u.R.Pipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		account = pipe.PTTL(u.Ctx, "login-wait:"+email)
+		address = pipe.PTTL(u.Ctx, "login-wait-ip:"+ip)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return max(account.Val(), address.Val(), 0), nil
+}
+
+// LoginFailed counts a failed login of email from ip and returns the
+// failures of both in the current window.
+func (u *Redis) LoginFailed(email, ip string, window time.Duration) (accountFailures, ipFailures int64, err error) {
+	var account, address *redis.IntCmd
+	_, err = u.R.TxPipelined(u.Ctx, func(pipe redis.Pipeliner) error {
+		account = pipe.Incr(u.Ctx, "login-failures:"+email)
+		pipe.ExpireNX(u.Ctx, "login-failures:"+email, window)
+		address = pipe.Incr(u.Ctx, "login-failures-ip:"+ip)
+		pipe.ExpireNX(u.Ctx, "login-failures-ip:"+ip, window)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return account.Val(), address.Val(), nil
+}
+
+// DelayLogins makes logins to email, or from ip when email is empty, wait.
+func (u *Redis) DelayLogins(email, ip string, wait time.Duration) error {
+	if email != "" {
+		return u.R.Set(u.Ctx, "login-wait:"+email, 1, wait).Err()
+	}
+	return u.R.Set(u.Ctx, "login-wait-ip:"+ip, 1, wait).Err()
+}
+
+// ClearLoginFailures forgets the failed logins of an account.
+func (u *Redis) ClearLoginFailures(email string) error {
+	return u.R.Del(u.Ctx, "login-failures:"+email, "login-wait:"+email).Err()
+}
@@ -25,13 +25,21 @@ func sendEmail(to, subject, body string) error {
 }
 
 func Sent(email string) string {
+	return sendCode(email, "New Notification", "Your confirmation code is %v")
+}
+
+// SentPasswordReset emails a password reset code and returns it.
+func SentPasswordReset(email string) string {
+	return sendCode(email, "Password reset", "Your password reset code is %v. If you didn't ask to reset your password, ignore this email.")
+}
+
+func sendCode(email, subject, format string) string {
 	code, err := generateRandomCode()
 	if err != nil {
 		log.Printf("Failed to generate code: %v", err)
 	}
-	body := fmt.Sprintf("Your confirmation code is %v", code)
+	body := fmt.Sprintf(format, code)
 	to := email
-	subject := "New Notification"
 
 	if err := sendEmail(to, subject, body); err != nil {
 		log.Printf("Failed to send email: %v", err)
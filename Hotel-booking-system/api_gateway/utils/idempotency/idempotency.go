@@ -0,0 +1,171 @@
+// Package idempotency lets clients retry POST, PUT and DELETE requests safely
+// by sending an Idempotency-Key header. The first request with a key is
+// served as usual and its successful response is kept for a day; repeating
+// the same request with the key replays that response instead of running
+// the handler again.
+package idempotency
+
+import (
+	"api-gateway/models"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Header is the request header that carries the key.
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set on responses replayed from an earlier request.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	// pendingTTL bounds how long a key stays locked if the gateway dies
+	// while the first request is still running.
+	pendingTTL  = time.Minute
+	responseTTL = 24 * time.Hour
+)
+
+// Store keeps the responses by key. *redismethod.Redis implements it;
+// GetIdempotencyKey must return redis.Nil for keys it does not hold.
+type Store interface {
+	ReserveIdempotencyKey(key string, req *models.IdempotentResponse, ttl time.Duration) (bool, error)
+	SaveIdempotencyKey(key string, req *models.IdempotentResponse, ttl time.Duration) error
+	GetIdempotencyKey(key string) (*models.IdempotentResponse, error)
+	ReleaseIdempotencyKey(key string) error
+}
+
+// Middleware applies Idempotency-Key handling to POST, PUT and DELETE
+// requests that carry the header. Keys are scoped to the Authorization
+// header, so two clients cannot see each other's responses.
+func Middleware(r Store, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key := req.Header.Get(Header)
+		if key == "" || (req.Method != http.MethodPost && req.Method != http.MethodPut && req.Method != http.MethodDelete) {
+			next.ServeHTTP(w, req)
+			return
+		}
+		if len(key) > maxKeyLength {
+			http.Error(w, "Idempotency-Key must be at most 255 characters", http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := "idempotency:" + hash([]byte(req.Header.Get("Authorization")), []byte(key))
+		fingerprint := hash([]byte(req.Method), []byte(req.URL.RequestURI()), body)
+
+		reserved, err := r.ReserveIdempotencyKey(storeKey, &models.IdempotentResponse{Fingerprint: fingerprint}, pendingTTL)
+		if err != nil {
+			// The consumers still dedupe Kafka commands by the key
+			log.Println(err)
+			next.ServeHTTP(w, req)
+			return
+		}
+		if !reserved {
+			replay(w, r, storeKey, fingerprint)
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, req)
+
+		if rec.status < 200 || rec.status >= 300 {
+			// Failed requests may be retried with the same key
+			if err := r.ReleaseIdempotencyKey(storeKey); err != nil {
+				log.Println(err)
+			}
+			return
+		}
+		res := &models.IdempotentResponse{
+			Fingerprint: fingerprint,
+			Done:        true,
+			Status:      rec.status,
+			ContentType: rec.Header().Get("Content-Type"),
+			Body:        rec.body.Bytes(),
+		}
+		if err := r.SaveIdempotencyKey(storeKey, res, responseTTL); err != nil {
+			log.Println(err)
+		}
+	})
+}
+
+// Key returns the Idempotency-Key of r scoped to owner, empty without the
+// header. The services dedupe Kafka commands by this key, so the same key
+// sent by two users never returns one user's result to the other.
+func Key(r *http.Request, owner string) string {
+	key := r.Header.Get(Header)
+	if key == "" {
+		return ""
+	}
+	return hash([]byte(owner), []byte(key))
+}
+
+func replay(w http.ResponseWriter, r Store, storeKey, fingerprint string) {
+	res, err := r.GetIdempotencyKey(storeKey)
+	if errors.Is(err, redis.Nil) {
+		// Released or expired just now
+		http.Error(w, "request with this Idempotency-Key is being processed, retry later", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case res.Fingerprint != fingerprint:
+		http.Error(w, "Idempotency-Key was already used with a different request", http.StatusUnprocessableEntity)
+	case !res.Done:
+		http.Error(w, "request with this Idempotency-Key is being processed, retry later", http.StatusConflict)
+	default:
+		if res.ContentType != "" {
+			w.Header().Set("Content-Type", res.ContentType)
+		}
+		w.Header().Set(ReplayedHeader, "true")
+		w.WriteHeader(res.Status)
+		w.Write(res.Body)
+	}
+}
+
+func hash(parts ...[]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recorder passes the response through and keeps a copy of it.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,226 @@
+package idempotency
+
+import (
+	"api-gateway/models"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// memStore keeps the responses in memory in place of Redis.
+type memStore map[string]models.IdempotentResponse
+
+func (s memStore) ReserveIdempotencyKey(key string, req *models.IdempotentResponse, _ time.Duration) (bool, error) {
+	if _, ok := s[key]; ok {
+		return false, nil
+	}
+	s[key] = *req
+	return true, nil
+}
+
+func (s memStore) SaveIdempotencyKey(key string, req *models.IdempotentResponse, _ time.Duration) error {
+	s[key] = *req
+	return nil
+}
+
+func (s memStore) GetIdempotencyKey(key string) (*models.IdempotentResponse, error) {
+	res, ok := s[key]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return &res, nil
+}
+
+func (s memStore) ReleaseIdempotencyKey(key string) error {
+	delete(s, key)
+	return nil
+}
+
+type call struct {
+	method, key, auth, body string
+	status                  int
+	replayed                bool
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		calls   []call
+		handled int
+	}{
+		{
+			name:   "repeated request is replayed",
+			status: http.StatusCreated,
+			calls: []call{
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusCreated},
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusCreated, replayed: true},
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusCreated, replayed: true},
+			},
+			handled: 1,
+		},
+		{
+			name:   "key reused with another body is rejected",
+			status: http.StatusCreated,
+			calls: []call{
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusCreated},
+				{method: http.MethodPost, key: "k", body: "b", status: http.StatusUnprocessableEntity},
+			},
+			handled: 1,
+		},
+		{
+			name:   "key reused with another method is rejected",
+			status: http.StatusOK,
+			calls: []call{
+				{method: http.MethodPut, key: "k", body: "a", status: http.StatusOK},
+				{method: http.MethodDelete, key: "k", body: "a", status: http.StatusUnprocessableEntity},
+			},
+			handled: 1,
+		},
+		{
+			name:   "failed request may be retried",
+			status: http.StatusInternalServerError,
+			calls: []call{
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusInternalServerError},
+				{method: http.MethodPost, key: "k", body: "a", status: http.StatusInternalServerError},
+			},
+			handled: 2,
+		},
+		{
+			name:   "keys are scoped to the caller",
+			status: http.StatusCreated,
+			calls: []call{
+				{method: http.MethodPost, key: "k", auth: "Bearer one", body: "a", status: http.StatusCreated},
+				{method: http.MethodPost, key: "k", auth: "Bearer two", body: "a", status: http.StatusCreated},
+			},
+			handled: 2,
+		},
+		{
+			name:   "requests without a key are not deduped",
+			status: http.StatusCreated,
+			calls: []call{
+				{method: http.MethodPost, body: "a", status: http.StatusCreated},
+				{method: http.MethodPost, body: "a", status: http.StatusCreated},
+			},
+			handled: 2,
+		},
+		{
+			name:   "GET requests are not deduped",
+			status: http.StatusOK,
+			calls: []call{
+				{method: http.MethodGet, key: "k", status: http.StatusOK},
+				{method: http.MethodGet, key: "k", status: http.StatusOK},
+			},
+			handled: 2,
+		},
+		{
+			name:   "too long key is rejected",
+			status: http.StatusCreated,
+			calls: []call{
+				{method: http.MethodPost, key: strings.Repeat("k", maxKeyLength+1), body: "a", status: http.StatusBadRequest},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := 0
+			h := Middleware(memStore{}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handled++
+				body, _ := io.ReadAll(r.Body)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"body":"` + string(body) + `"}`))
+			}))
+
+			var first string
+			for i, c := range tt.calls {
+				req := httptest.NewRequest(c.method, "/bookings", strings.NewReader(c.body))
+				if c.key != "" {
+					req.Header.Set(Header, c.key)
+				}
+				if c.auth != "" {
+					req.Header.Set("Authorization", c.auth)
+				}
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, req)
+
+				if w.Code != c.status {
+					t.Errorf("call %d: status %d, want %d", i, w.Code, c.status)
+				}
+				if replayed := w.Header().Get(ReplayedHeader) == "true"; replayed != c.replayed {
+					t.Errorf("call %d: replayed %v, want %v", i, replayed, c.replayed)
+				}
+				if i == 0 {
+					first = w.Body.String()
+				} else if c.replayed {
+					if w.Body.String() != first || w.Header().Get("Content-Type") != "application/json" {
+						t.Errorf("call %d: replayed %q %q, want %q", i, w.Header().Get("Content-Type"), w.Body.String(), first)
+					}
+				}
+			}
+			if handled != tt.handled {
+				t.Errorf("handler ran %d times, want %d", handled, tt.handled)
+			}
+		})
+	}
+}
+
+func TestMiddlewarePending(t *testing.T) {
+	var h http.Handler
+	var inner *httptest.ResponseRecorder
+	h = Middleware(memStore{}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if inner == nil {
+			// Retry while the first request is still running
+			inner = httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/bookings", strings.NewReader("a"))
+			req.Header.Set(Header, "k")
+			h.ServeHTTP(inner, req)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/bookings", strings.NewReader("a"))
+	req.Header.Set(Header, "k")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("first request: status %d, want %d", w.Code, http.StatusCreated)
+	}
+	if inner.Code != http.StatusConflict {
+		t.Errorf("retry while pending: status %d, want %d", inner.Code, http.StatusConflict)
+	}
+}
+
+func TestKey(t *testing.T) {
+	req := func(key string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/bookings", nil)
+		if key != "" {
+			r.Header.Set(Header, key)
+		}
+		return r
+	}
+	tests := []struct {
+		name       string
+		a, b       string
+		ownA, ownB string
+		same       bool
+	}{
+		{name: "same key and owner", a: "k", b: "k", ownA: "1", ownB: "1", same: true},
+		{name: "same key, other owner", a: "k", b: "k", ownA: "1", ownB: "2"},
+		{name: "other key, same owner", a: "k", b: "j", ownA: "1", ownB: "1"},
+	}
+	for _, tt := range tests {
+		if same := Key(req(tt.a), tt.ownA) == Key(req(tt.b), tt.ownB); same != tt.same {
+			t.Errorf("%s: same %v, want %v", tt.name, same, tt.same)
+		}
+	}
+	if k := Key(req(""), "1"); k != "" {
+		t.Errorf("Key() without header = %q, want empty", k)
+	}
+}
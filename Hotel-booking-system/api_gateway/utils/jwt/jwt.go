@@ -3,31 +3,152 @@ package jwttoken
 import (
 	"api-gateway/models"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var (
-	secretKey = []byte("said1902")
+const (
+	// AccessTTL is how long an access token is valid; clients renew it
+	// with their refresh token.
+	AccessTTL = 15 * time.Minute
+	// RefreshTTL is how long a session lasts without being refreshed.
+	RefreshTTL = 30 * 24 * time.Hour
 )
 
-func CreateToken(req *models.LogInRequest) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"email":      req.Email,
-			"created_at": time.Now().Unix(),
-			"exp":        time.Now().Add(time.Hour * 24).Unix(),
+var revocations Revocations
+
+// Revocations tells JWTMiddleware which tokens were revoked before they expired.
+type Revocations interface {
+	// Revoked reports whether the session was ended, or whether every token
+	// the user was issued up to issuedAt was.
+	Revoked(sessionID string, userID int32, issuedAt time.Time) (bool, error)
+}
+
+// UseRevocations makes JWTMiddleware refuse the tokens r reports revoked.
+func UseRevocations(r Revocations) {
+	revocations = r
+}
+
+// Claims is who a token was issued to. HotelIDs are the hotels a manager
+// runs, SessionID the login the token belongs to.
+type Claims struct {
+	UserID    int32
+	Email     string
+	Roles     []string
+	HotelIDs  []int32
+	SessionID string
+	IssuedAt  time.Time
+}
+
+// HasRole reports whether the holder has role. A nil Claims has no roles.
+func (c *Claims) HasRole(role string) bool {
+	return c != nil && slices.Contains(c.Roles, role)
+}
+
+// CanAccess reports whether the holder may read or change the records of
+// userID: their own, or anyone's for an admin.
+func (c *Claims) CanAccess(userID int32) bool {
+	return c != nil && (c.UserID == userID || c.HasRole(models.RoleAdmin))
+}
+
+// Manages reports whether the holder may run hotelID: as an admin, or as
+// a manager assigned to it.
+func (c *Claims) Manages(hotelID int32) bool {
+	return c.HasRole(models.RoleAdmin) || (c.HasRole(models.RoleManager) && slices.Contains(c.HotelIDs, hotelID))
+}
+
+type tokenClaims struct {
+	Email     string   `json:"email"`
+	Roles     []string `json:"roles"`
+	HotelIDs  []int32  `json:"hotels,omitempty"`
+	SessionID string   `json:"sid"`
+	// CreatedAt is when the token was issued in Unix milliseconds; iat only
+	// has whole seconds, too coarse to tell a token from a revocation
+	// made in the same second.
+	CreatedAt int64 `json:"created_at"`
+	jwt.RegisteredClaims
+}
+
+type claimsKey struct{}
+
+// FromContext returns the claims JWTMiddleware stored in ctx, nil without any.
+func FromContext(ctx context.Context) *Claims {
+	c, _ := ctx.Value(claimsKey{}).(*Claims)
+	return c
+}
+
+// CreateToken issues an access token valid for AccessTTL, signed with the
+// signing key of UseKeys and its kid in the header.
+func CreateToken(c *Claims) (string, error) {
+	if keys == nil {
+		return "", errors.New("no JWT signing keys, call UseKeys")
+	}
+	id, err := randomString(16)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(keys.signing.Method,
+		tokenClaims{
+			Email:     c.Email,
+			Roles:     c.Roles,
+			HotelIDs:  c.HotelIDs,
+			SessionID: c.SessionID,
+			CreatedAt: now.UnixMilli(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        id,
+				Subject:   strconv.Itoa(int(c.UserID)),
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(AccessTTL)),
+			},
 		})
-	tokenString, err := token.SignedString(secretKey)
+	token.Header["kid"] = keys.signing.ID
+	tokenString, err := token.SignedString(keys.signing.Private)
 	if err != nil {
-		return "", nil
+		return "", err
 	}
 	return tokenString, nil
 }
 
+// NewSessionID names a new login.
+func NewSessionID() (string, error) {
+	return randomString(16)
+}
+
+// NewRefreshToken returns a random refresh token and the hash it is stored
+// under; the token itself is only ever given to the client.
+func NewRefreshToken() (token, hash string, err error) {
+	token, err = randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return token, HashRefreshToken(token), nil
+}
+
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func JWTMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		authHeader := r.Header.Get("Authorization")
@@ -42,24 +163,42 @@ func JWTMiddleware(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, http.ErrNoCookie
-			}
-			return secretKey, nil
-		})
+		var claims tokenClaims
+		token, err := jwt.ParseWithClaims(tokenString, &claims, keys.verifying,
+			jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 		if err != nil || !token.Valid {
 			http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
 			return
 		}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			ctx := context.WithValue(r.Context(), "email", claims["email"])
-			next.ServeHTTP(w, r.WithContext(ctx))
-		} else {
+		// Tokens issued before they carried the user id and session are
+		// refused, their holders have to log in again
+		id, err := strconv.Atoi(claims.Subject)
+		if err != nil || claims.SessionID == "" || claims.IssuedAt == nil || claims.CreatedAt == 0 {
 			http.Error(w, "Invalid token claims", http.StatusUnauthorized)
 			return
 		}
+		if revocations != nil {
+			revoked, err := revocations.Revoked(claims.SessionID, int32(id), time.UnixMilli(claims.CreatedAt))
+			if err != nil {
+				log.Println(err)
+				http.Error(w, "Could not check the token", http.StatusInternalServerError)
+				return
+			}
+			if revoked {
+				http.Error(w, "Token has been revoked", http.StatusUnauthorized)
+				return
+			}
+		}
+		ctx := context.WithValue(r.Context(), claimsKey{}, &Claims{
+			UserID:    int32(id),
+			Email:     claims.Email,
+			Roles:     claims.Roles,
+			HotelIDs:  claims.HotelIDs,
+			SessionID: claims.SessionID,
+			IssuedAt:  time.UnixMilli(claims.CreatedAt),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 }
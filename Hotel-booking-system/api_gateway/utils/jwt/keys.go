@@ -0,0 +1,217 @@
+package jwttoken
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// key is a signing key; retired keys only have Public and keep verifying
+// the tokens they signed until those expire.
+type key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+// KeySet holds the keys tokens are verified with and the one new tokens are
+// signed with.
+type KeySet struct {
+	signing *key
+	keys    map[string]*key
+	ids     []string
+}
+
+var keys *KeySet
+
+// UseKeys makes CreateToken and JWTMiddleware use ks.
+func UseKeys(ks *KeySet) {
+	keys = ks
+}
+
+// LoadKeys reads the PEM files of dir. The file name is the kid of the key:
+// <kid>.pem holds an RSA or Ed25519 private key, <kid>.pub.pem the public
+// key of a retired one. signingID picks the key to sign with, by default
+// the last private key by name, so keys named by date rotate by adding a
+// file. Without any private key a throwaway Ed25519 key is generated, fine
+// for one gateway in development but not for several.
+func LoadKeys(dir, signingID string) (*KeySet, error) {
+	ks := &KeySet{keys: map[string]*key{}}
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		id := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(file), ".pem"), ".pub")
+		k, err := parseKey(id, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if err := ks.add(k); err != nil {
+			return nil, err
+		}
+		if k.Private != nil && signingID == "" {
+			ks.signing = k
+		}
+	}
+	if signingID != "" {
+		k, ok := ks.keys[signingID]
+		if !ok || k.Private == nil {
+			return nil, fmt.Errorf("no private key %q in %s", signingID, dir)
+		}
+		ks.signing = k
+	}
+	if ks.signing == nil {
+		log.Printf("no JWT signing key in %s, using a generated one: tokens will not survive a restart", dir)
+		k, err := generateKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := ks.add(k); err != nil {
+			return nil, err
+		}
+		ks.signing = k
+	}
+	return ks, nil
+}
+
+func (ks *KeySet) add(k *key) error {
+	if _, ok := ks.keys[k.ID]; ok {
+		return fmt.Errorf("two keys with kid %q", k.ID)
+	}
+	ks.keys[k.ID] = k
+	ks.ids = append(ks.ids, k.ID)
+	return nil
+}
+
+// verifying finds the key of a token by its kid header.
+func (ks *KeySet) verifying(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header["kid"].(string)
+	k, ok := ks.keys[id]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	if token.Method.Alg() != k.Method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return k.Public, nil
+}
+
+func parseKey(id string, data []byte) (*key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	var (
+		parsed interface{}
+		err    error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k := &key{ID: id}
+	switch v := parsed.(type) {
+	case *rsa.PrivateKey:
+		k.Method, k.Private, k.Public = jwt.SigningMethodRS256, v, &v.PublicKey
+	case ed25519.PrivateKey:
+		k.Method, k.Private, k.Public = jwt.SigningMethodEdDSA, v, v.Public()
+	case *rsa.PublicKey:
+		k.Method, k.Public = jwt.SigningMethodRS256, v
+	case ed25519.PublicKey:
+		k.Method, k.Public = jwt.SigningMethodEdDSA, v
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", parsed)
+	}
+	if pub, ok := k.Public.(*rsa.PublicKey); ok && pub.N.BitLen() < 2048 {
+		return nil, errors.New("RSA keys must have at least 2048 bits")
+	}
+	return k, nil
+}
+
+func generateKey() (*key, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id, err := randomString(8)
+	if err != nil {
+		return nil, err
+	}
+	return &key{ID: "generated-" + id, Method: jwt.SigningMethodEdDSA, Private: priv, Public: pub}, nil
+}
+
+// JWK is a public key as published in the JWKS, RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS lists the public keys tokens may be signed with, retired ones
+// included, so that other services can verify tokens by their kid.
+func (ks *KeySet) JWKS() *JWKS {
+	res := &JWKS{Keys: []JWK{}}
+	for _, id := range ks.ids {
+		k := ks.keys[id]
+		jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+		switch pub := k.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		res.Keys = append(res.Keys, jwk)
+	}
+	return res
+}
+
+// PublicKeys returns the JWKS of the keys in use.
+func PublicKeys() *JWKS {
+	return keys.JWKS()
+}
@@ -25,6 +25,14 @@ func main() {
 	booking.RegisterBookHotelServer(s, server)
 	reflection.Register(s)
 	a := connections.NewConsumer()
+	if err := a.A.Resume(a.Ctx); err != nil {
+		log.Println(err)
+	}
+	if err := a.A.ReleaseLegacyHolds(a.Ctx); err != nil {
+		log.Println(err)
+	}
+	go a.A.ExpireOffers(a.Ctx)
+	go a.A.AssignRooms(a.Ctx)
 	
 	go func() {
 		a.Consumer()
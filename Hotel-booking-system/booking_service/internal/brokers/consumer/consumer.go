@@ -1,12 +1,16 @@
 package kafkaconsumer
 
 import (
+	"booking-service/internal/brokers/producer"
 	interfaceservices "booking-service/internal/interface/services"
 	"booking-service/models"
 	"booking-service/pkg/protos/booking"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/twmb/franz-go/pkg/kgo"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -14,6 +18,7 @@ import (
 
 type Consumer17 struct {
 	A   *interfaceservices.AdjustDatabase
+	S   *interfaceservices.Database
 	Ctx context.Context
 }
 
@@ -43,70 +48,148 @@ func (u *Consumer17) Consumer() {
 }
 
 func (u *Consumer17) Adjust(record *kgo.Record) error {
-	switch string(record.Key) {
-	case "create":
-		if err := u.Create(record.Value); err != nil {
-			log.Println(err)
+	key := header(record, producer.IdempotencyHeader)
+	if key != "" {
+		done, err := u.S.GetProcessedKey(u.Ctx, key)
+		if err == nil {
+			// A retried or redelivered command gets the first result back
+			// instead of running again
+			if done.Kind != string(record.Key) {
+				err = fmt.Errorf("idempotency key %q was already used for a %s command", key, done.Kind)
+				u.record(record, nil, err)
+				return err
+			}
+			u.record(record, &models.Command{EntityID: done.EntityID, Message: done.Message}, nil)
 			return nil
 		}
-	case "update":
-		if err := u.Update(record.Value); err != nil {
-			log.Println(err)
+		if !errors.Is(err, models.ErrKeyNotProcessed) {
+			// Without the lookup a duplicate cannot be ruled out
+			u.record(record, nil, err)
 			return err
 		}
+	}
+
+	var (
+		res *models.Command
+		err error
+	)
+	switch string(record.Key) {
+	case "create":
+		res, err = u.Create(record.Value)
+	case "update":
+		res, err = u.Update(record.Value)
 	case "delete":
-		if err := u.Delete(record.Value); err != nil {
-			log.Println(err)
-			return err
-		}
+		res, err = u.Delete(record.Value)
 	case "createW":
-		if err := u.CreateW(record.Value); err != nil {
-			log.Println(err)
-			return nil
-		}
+		res, err = u.CreateW(record.Value)
 	case "updateW":
-		if err := u.UpdateW(record.Value); err != nil {
-			log.Println(err)
-			return nil
-		}
+		res, err = u.UpdateW(record.Value)
 	case "deleteW":
-		if err := u.DeleteW(record.Value); err != nil {
+		res, err = u.DeleteW(record.Value)
+	default:
+		return nil
+	}
+	// Only successful results are kept, a failed command may be retried
+	if key != "" && err == nil {
+		processed := &models.ProcessedKey{
+			Key:       key,
+			Kind:      string(record.Key),
+			CommandID: header(record, producer.CommandHeader),
+			EntityID:  res.EntityID,
+			Message:   res.Message,
+		}
+		if _, err := u.S.SaveProcessedKey(u.Ctx, processed); err != nil {
 			log.Println(err)
-			return nil
 		}
 	}
-	return nil
+	u.record(record, res, err)
+	return err
 }
 
-func (u *Consumer17) Create(req []byte) error {
+func header(record *kgo.Record, key string) string {
+	for _, h := range record.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// userID is the user who issued the command, zero for records produced
+// before the header existed.
+func userID(record *kgo.Record) int32 {
+	id, _ := strconv.Atoi(header(record, producer.UserHeader))
+	return int32(id)
+}
+
+// record saves the result of a command under the id from its header.
+// Records produced before command ids existed carry no header and are skipped.
+func (u *Consumer17) record(record *kgo.Record, res *models.Command, err error) {
+	id := header(record, producer.CommandHeader)
+	if id == "" {
+		return
+	}
+
+	if res == nil {
+		res = &models.Command{}
+	}
+	res.ID = id
+	res.Kind = string(record.Key)
+	res.UserID = userID(record)
+	res.Status = models.CommandSucceeded
+	if err != nil {
+		res.Status = models.CommandFailed
+		res.Error = err.Error()
+	}
+	if _, err := u.S.SaveCommand(u.Ctx, res); err != nil {
+		log.Println(err)
+	}
+}
+
+// result builds a command result. Creating commands return the new id as
+// their message, so it is used when the command has no id of its own.
+func result(id int32, res *booking.GeneralResponse) *models.Command {
+	if id == 0 {
+		if n, err := strconv.Atoi(res.Message); err == nil {
+			id = int32(n)
+		}
+	}
+	return &models.Command{EntityID: id, Message: res.Message}
+}
+
+func (u *Consumer17) Create(req []byte) (*models.Command, error) {
 	var req1 models.BookHotelRequest
 
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	var newreq = booking.BookHotelRequest{
-		UserID:       req1.UserID,
-		HotelID:      req1.HotelID,
-		RoomId:       req1.RoomID,
-		RoomType:     req1.RoomType,
-		CheckInDate:  timestamppb.New(req1.CheckInDate),
-		CheckOutDate: timestamppb.New(req1.CheckOutDate),
+		UserID:         req1.UserID,
+		HotelID:        req1.HotelID,
+		RoomId:         req1.RoomID,
+		RoomType:       req1.RoomType,
+		CheckInDate:    timestamppb.New(req1.CheckInDate),
+		CheckOutDate:   timestamppb.New(req1.CheckOutDate),
+		PaymentToken:   req1.PaymentToken,
+		AssignLater:    req1.AssignLater,
+		PreferredFloor: req1.PreferredFloor,
+		RoomAmenities:  req1.RoomAmenities,
 	}
-	_, err := u.A.Create(u.Ctx, &newreq)
+	res, err := u.A.Create(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(0, res), nil
 }
 
-func (u *Consumer17) Update(req []byte) error {
+func (u *Consumer17) Update(req []byte) (*models.Command, error) {
 	var req1 models.BookHotelUpdateRequest
 
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	var newreq = booking.BookHotelUpdateRequest{
 		Id:           req1.ID,
@@ -114,40 +197,42 @@ func (u *Consumer17) Update(req []byte) error {
 		RoomType:     req1.RoomType,
 		CheckInDate:  req1.CheckInDate,
 		CheckOutDate: req1.CheckOutDate,
+		PaymentToken: req1.PaymentToken,
 	}
-	_, err := u.A.Update(u.Ctx, &newreq)
+	res, err := u.A.Update(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(req1.ID, res), nil
 }
 
-func (u *Consumer17) Delete(req []byte) error {
+func (u *Consumer17) Delete(req []byte) (*models.Command, error) {
 	var req1 models.CancelRoomRequest
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
 	var newreq = booking.CancelROomRequest{
-		Id: req1.ID,
+		Id:     req1.ID,
+		Reason: req1.Reason,
 	}
 
-	_, err := u.A.Cancel(u.Ctx, &newreq)
+	res, err := u.A.Cancel(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(req1.ID, res), nil
 }
 
-func (u *Consumer17) CreateW(req []byte) error {
+func (u *Consumer17) CreateW(req []byte) (*models.Command, error) {
 	var req1 models.CreateWaitingList
 
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	var newreq = booking.CreateWaitingList{
 		UserId:       req1.UserID,
@@ -157,19 +242,19 @@ func (u *Consumer17) CreateW(req []byte) error {
 		CheckInDate:  timestamppb.New(req1.CheckInDate),
 		CheckOutDate: timestamppb.New(req1.CheckOutDate),
 	}
-	_, err := u.A.CreateW(u.Ctx, &newreq)
+	res, err := u.A.CreateW(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(0, res), nil
 }
 
-func (u *Consumer17) UpdateW(req []byte) error {
+func (u *Consumer17) UpdateW(req []byte) (*models.Command, error) {
 	var req1 models.UpdateWaitingListRequest
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
 	var newreq = booking.UpdateWaitingListRequest{
@@ -180,29 +265,29 @@ func (u *Consumer17) UpdateW(req []byte) error {
 		CheckInDate:  timestamppb.New(req1.CheckInDate),
 		CheckOutDate: timestamppb.New(req1.CheckOutDate),
 	}
-	_, err := u.A.UpdateW(u.Ctx, &newreq)
+	res, err := u.A.UpdateW(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(req1.ID, res), nil
 }
 
-func (u *Consumer17) DeleteW(req []byte) error {
+func (u *Consumer17) DeleteW(req []byte) (*models.Command, error) {
 	var req1 models.DeleteWaitingList
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
 	var newreq = booking.DeleteWaitingList{
 		Id: req1.ID,
 	}
 
-	_, err := u.A.DeleteW(u.Ctx, &newreq)
+	res, err := u.A.DeleteW(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return result(req1.ID, res), nil
 }
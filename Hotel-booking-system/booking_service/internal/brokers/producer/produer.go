@@ -2,12 +2,28 @@ package producer
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/twmb/franz-go/pkg/kgo"
 )
 
-func Producer(key, topic string, req []byte) error {
+const (
+	// CommandHeader carries the command id, so the consumer can record the
+	// result under the id the caller was given.
+	CommandHeader = "command_id"
+	// IdempotencyHeader carries the client's Idempotency-Key, scoped to the
+	// caller by the gateway, so the consumer can skip a request it has
+	// already processed.
+	IdempotencyHeader = "idempotency_key"
+	// UserHeader carries the id of the user who issued the command, so only
+	// they and admins can read its result.
+	UserHeader = "user_id"
+)
+
+func Producer(key, topic string, req []byte, headers ...kgo.RecordHeader) error {
 	client, err := kgo.NewClient(
 		kgo.SeedBrokers("localhost:9092"),
 		kgo.AllowAutoTopicCreation(),
@@ -32,12 +48,36 @@ func Producer(key, topic string, req []byte) error {
 	//with key
 
 	record := kgo.Record{
-		Key:   []byte(key),
-		Topic: topic,
-		Value: req,
+		Key:     []byte(key),
+		Topic:   topic,
+		Value:   req,
+		Headers: headers,
 	}
 	if err := client.ProduceSync(ctx, &record).FirstErr(); err != nil {
 		log.Println(err)
+		return err
 	}
 	return nil
 }
+
+// NewCommandID returns a random UUID for a new command.
+func NewCommandID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Headers returns the headers that tag a record with its command id, the
+// user who issued it and, when the client sent one, its idempotency key.
+func Headers(commandID, idempotencyKey string, userID int32) []kgo.RecordHeader {
+	headers := []kgo.RecordHeader{
+		{Key: CommandHeader, Value: []byte(commandID)},
+		{Key: UserHeader, Value: []byte(strconv.Itoa(int(userID)))},
+	}
+	if idempotencyKey != "" {
+		headers = append(headers, kgo.RecordHeader{Key: IdempotencyHeader, Value: []byte(idempotencyKey)})
+	}
+	return headers
+}
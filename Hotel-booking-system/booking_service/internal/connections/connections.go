@@ -8,6 +8,8 @@ import (
 	userservice "booking-service/internal/clients/user"
 	interface17 "booking-service/internal/interface"
 	interfaceservices "booking-service/internal/interface/services"
+	"booking-service/internal/payment"
+	"booking-service/internal/saga"
 	"booking-service/internal/service/adjsut"
 	grpcmethods "booking-service/internal/service/methods"
 	"booking-service/pkg/database/methods"
@@ -41,7 +43,9 @@ func NewAdjust() interface17.BookingAdjust {
 	user := userservice.UserClinet()
 	hotel := hotelservice.Hotel()
 	n := notification17.Hotel()
-	return &adjsut.Adjust{S: a, User: user, Hotel: hotel, N: n}
+	adjust := &adjsut.Adjust{S: a, User: user, Hotel: hotel, N: n, P: payment.NewFake(), Saga: saga.NewOrchestrator(a)}
+	adjust.RegisterSagas()
+	return adjust
 }
 
 func NewAdjus() *interfaceservices.AdjustDatabase {
@@ -56,6 +60,7 @@ func NewGrpc() *grpcmethods.Grpc {
 
 func NewConsumer() *kafkaconsumer.Consumer17 {
 	a := NewAdjus()
+	s := NewService()
 	ctx := context.Background()
-	return &kafkaconsumer.Consumer17{A: a, Ctx: ctx}
+	return &kafkaconsumer.Consumer17{A: a, S: s, Ctx: ctx}
 }
@@ -7,15 +7,44 @@ import (
 )
 
 type Booking interface {
-	Create(ctx context.Context, req *models.BookHotelRequest, price float64) (*models.GeneralResponse, error)
+	Create(ctx context.Context, req *models.BookHotelRequest, quote *models.Quote) (*models.GeneralResponse, error)
 	Get(ctx context.Context, req *models.GetUsersBookRequest) (*models.GetUsersBookResponse, error)
 	GetRoomInfo(ctx context.Context, req *models.GetRoomInfo) (*models.GetUsersBookResponse, error)
-	Update(ctx context.Context, req *models.BookHotelUpdateRequest, price float64) (*models.GeneralResponse, error)
+	Update(ctx context.Context, req *models.BookHotelUpdateRequest, quote *models.Quote) (*models.GeneralResponse, error)
+	Nights(ctx context.Context, req *models.GetUsersBookRequest) ([]*models.NightPrice, error)
 	Cancel(ctx context.Context, req *models.CancelRoomRequest) (*models.GeneralResponse, error)
+	UpdateStatus(ctx context.Context, req *models.UpdateStatusRequest) (*models.GeneralResponse, error)
 	CreateW(ctx context.Context, req *models.CreateWaitingList) (*models.GeneralResponse, error)
 	GetW(ctx context.Context, req *models.GetWaitinglistRequest) (*models.GetWaitinglistResponse, error)
 	UpdateW(ctx context.Context, req *models.UpdateWaitingListRequest) (*models.GeneralResponse, error)
 	DeleteW(ctx context.Context, req *models.DeleteWaitingList) (*models.GeneralResponse, error)
+	MatchW(ctx context.Context, req *models.WaitingMatchRequest) ([]*models.GetWaitinglistResponse, error)
+	ExpiredOffers(ctx context.Context) ([]*models.GetWaitinglistResponse, error)
+	OfferW(ctx context.Context, req *models.OfferRequest) (*models.GeneralResponse, error)
+	UpdateWaitingStatus(ctx context.Context, req *models.WaitingStatusRequest) (*models.GeneralResponse, error)
+	BookedRooms(ctx context.Context, req *models.CheckAvailabilityRequest) ([]int32, error)
+	UnassignedLoad(ctx context.Context, req *models.LoadRequest) ([]*models.TypeLoad, error)
+	AssignRoom(ctx context.Context, req *models.AssignRoomRequest) (*models.GeneralResponse, error)
+	ReassignRoom(ctx context.Context, req *models.AssignRoomRequest) (*models.GeneralResponse, error)
+	Unassigned(ctx context.Context, req *models.UnassignedRequest) ([]*models.GetUsersBookResponse, error)
+	ListBookings(ctx context.Context, req *models.ListRequest) ([]*models.GetUsersBookResponse, error)
+	ListWaitingList(ctx context.Context, req *models.ListRequest) ([]*models.GetWaitinglistResponse, error)
+	HotelBookings(ctx context.Context, req *models.CalendarRequest) ([]*models.GetUsersBookResponse, error)
+	NightSales(ctx context.Context, req *models.ReportRequest) ([]*models.NightSales, error)
+	Arrivals(ctx context.Context, req *models.ReportRequest) ([]*models.Arrivals, error)
+	Neighbours(ctx context.Context, req *models.RoomNeighbours) ([]*models.Neighbours, error)
+	CreatePayment(ctx context.Context, req *models.Payment, event *models.PaymentEvent) (*models.GeneralResponse, error)
+	GetPayment(ctx context.Context, req *models.GetUsersBookRequest) (*models.Payment, error)
+	UpdatePayment(ctx context.Context, req *models.UpdatePaymentRequest) (*models.GeneralResponse, error)
+	CreateSaga(ctx context.Context, req *models.Saga) (*models.GeneralResponse, error)
+	UpdateSaga(ctx context.Context, req *models.Saga, step *models.SagaStep) (*models.GeneralResponse, error)
+	PendingSagas(ctx context.Context) ([]*models.Saga, error)
+	SaveCommand(ctx context.Context, req *models.Command) (*models.GeneralResponse, error)
+	GetCommand(ctx context.Context, req *models.GetCommandRequest) (*models.Command, error)
+	SaveProcessedKey(ctx context.Context, req *models.ProcessedKey) (*models.GeneralResponse, error)
+	GetProcessedKey(ctx context.Context, key string) (*models.ProcessedKey, error)
+	LegacyHolds(ctx context.Context) ([]*models.RoomHold, error)
+	DeleteLegacyHold(ctx context.Context, req *models.RoomHold) (*models.GeneralResponse, error)
 }
 
 type BookingAdjust interface {
@@ -23,8 +52,27 @@ type BookingAdjust interface {
 	Get(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GetUsersBookResponse, error)
 	Update(ctx context.Context, req *booking.BookHotelUpdateRequest) (*booking.GeneralResponse, error)
 	Cancel(ctx context.Context, req *booking.CancelROomRequest) (*booking.GeneralResponse, error)
+	CheckIn(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error)
+	CheckOut(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error)
+	MarkNoShow(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error)
 	CreateW(ctx context.Context, req *booking.CreateWaitingList) (*booking.GeneralResponse, error)
 	GetW(ctx context.Context, req *booking.GetWaitinglistRequest) (*booking.GetWaitinglistResponse, error)
 	UpdateW(ctx context.Context, req *booking.UpdateWaitingListRequest) (*booking.GeneralResponse, error)
 	DeleteW(ctx context.Context, req *booking.DeleteWaitingList) (*booking.GeneralResponse, error)
+	CheckAvailability(ctx context.Context, req *booking.CheckAvailabilityRequest) (*booking.CheckAvailabilityResponse, error)
+	GetPayment(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.Payment, error)
+	Resume(ctx context.Context) error
+	ReleaseLegacyHolds(ctx context.Context) error
+	AcceptOffer(ctx context.Context, req *booking.AcceptOfferRequest) (*booking.GeneralResponse, error)
+	ExpireOffers(ctx context.Context)
+	AssignRooms(ctx context.Context)
+	SearchAvailability(ctx context.Context, req *booking.SearchAvailabilityRequest) (*booking.SearchAvailabilityResponse, error)
+	AssignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error)
+	ReassignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error)
+	ListBookings(ctx context.Context, req *booking.ListRequest) (*booking.ListBookingsResponse, error)
+	ListWaitingList(ctx context.Context, req *booking.ListRequest) (*booking.ListWaitingListResponse, error)
+	Getall(ctx context.Context, req *booking.Request) (*booking.Response, error)
+	GetOccupancyCalendar(ctx context.Context, req *booking.GetOccupancyCalendarRequest) (*booking.OccupancyCalendar, error)
+	GetReport(ctx context.Context, req *booking.ReportRequest) (*booking.Report, error)
+	GetCommandStatus(ctx context.Context, req *booking.GetCommandStatusRequest) (*booking.CommandStatus, error)
 }
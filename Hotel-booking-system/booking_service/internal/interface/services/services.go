@@ -15,18 +15,24 @@ type AdjustDatabase struct {
 	A interface17.BookingAdjust
 }
 
-func (u *Database) Create(ctx context.Context, req *models.BookHotelRequest, price float64) (*models.GeneralResponse, error) {
-	return u.D.Create(ctx, req, price)
+func (u *Database) Create(ctx context.Context, req *models.BookHotelRequest, quote *models.Quote) (*models.GeneralResponse, error) {
+	return u.D.Create(ctx, req, quote)
 }
 func (u *Database) Get(ctx context.Context, req *models.GetUsersBookRequest) (*models.GetUsersBookResponse, error) {
 	return u.D.Get(ctx, req)
 }
-func (u *Database) Update(ctx context.Context, req *models.BookHotelUpdateRequest, price float64) (*models.GeneralResponse, error) {
-	return u.D.Update(ctx, req, price)
+func (u *Database) Update(ctx context.Context, req *models.BookHotelUpdateRequest, quote *models.Quote) (*models.GeneralResponse, error) {
+	return u.D.Update(ctx, req, quote)
+}
+func (u *Database) Nights(ctx context.Context, req *models.GetUsersBookRequest) ([]*models.NightPrice, error) {
+	return u.D.Nights(ctx, req)
 }
 func (u *Database) Cancel(ctx context.Context, req *models.CancelRoomRequest) (*models.GeneralResponse, error) {
 	return u.D.Cancel(ctx, req)
 }
+func (u *Database) UpdateStatus(ctx context.Context, req *models.UpdateStatusRequest) (*models.GeneralResponse, error) {
+	return u.D.UpdateStatus(ctx, req)
+}
 func (u *Database) GetRoomInfo(ctx context.Context, req *models.GetRoomInfo) (*models.GetUsersBookResponse, error) {
 	return u.D.GetRoomInfo(ctx, req)
 }
@@ -42,6 +48,87 @@ func (u *Database) UpdateW(ctx context.Context, req *models.UpdateWaitingListReq
 func (u *Database) DeleteW(ctx context.Context, req *models.DeleteWaitingList) (*models.GeneralResponse, error) {
 	return u.D.DeleteW(ctx, req)
 }
+func (u *Database) MatchW(ctx context.Context, req *models.WaitingMatchRequest) ([]*models.GetWaitinglistResponse, error) {
+	return u.D.MatchW(ctx, req)
+}
+func (u *Database) ExpiredOffers(ctx context.Context) ([]*models.GetWaitinglistResponse, error) {
+	return u.D.ExpiredOffers(ctx)
+}
+func (u *Database) OfferW(ctx context.Context, req *models.OfferRequest) (*models.GeneralResponse, error) {
+	return u.D.OfferW(ctx, req)
+}
+func (u *Database) UpdateWaitingStatus(ctx context.Context, req *models.WaitingStatusRequest) (*models.GeneralResponse, error) {
+	return u.D.UpdateWaitingStatus(ctx, req)
+}
+func (u *Database) BookedRooms(ctx context.Context, req *models.CheckAvailabilityRequest) ([]int32, error) {
+	return u.D.BookedRooms(ctx, req)
+}
+func (u *Database) UnassignedLoad(ctx context.Context, req *models.LoadRequest) ([]*models.TypeLoad, error) {
+	return u.D.UnassignedLoad(ctx, req)
+}
+func (u *Database) AssignRoom(ctx context.Context, req *models.AssignRoomRequest) (*models.GeneralResponse, error) {
+	return u.D.AssignRoom(ctx, req)
+}
+func (u *Database) ReassignRoom(ctx context.Context, req *models.AssignRoomRequest) (*models.GeneralResponse, error) {
+	return u.D.ReassignRoom(ctx, req)
+}
+func (u *Database) Unassigned(ctx context.Context, req *models.UnassignedRequest) ([]*models.GetUsersBookResponse, error) {
+	return u.D.Unassigned(ctx, req)
+}
+func (u *Database) ListBookings(ctx context.Context, req *models.ListRequest) ([]*models.GetUsersBookResponse, error) {
+	return u.D.ListBookings(ctx, req)
+}
+func (u *Database) ListWaitingList(ctx context.Context, req *models.ListRequest) ([]*models.GetWaitinglistResponse, error) {
+	return u.D.ListWaitingList(ctx, req)
+}
+func (u *Database) HotelBookings(ctx context.Context, req *models.CalendarRequest) ([]*models.GetUsersBookResponse, error) {
+	return u.D.HotelBookings(ctx, req)
+}
+func (u *Database) NightSales(ctx context.Context, req *models.ReportRequest) ([]*models.NightSales, error) {
+	return u.D.NightSales(ctx, req)
+}
+func (u *Database) Arrivals(ctx context.Context, req *models.ReportRequest) ([]*models.Arrivals, error) {
+	return u.D.Arrivals(ctx, req)
+}
+func (u *Database) Neighbours(ctx context.Context, req *models.RoomNeighbours) ([]*models.Neighbours, error) {
+	return u.D.Neighbours(ctx, req)
+}
+func (u *Database) CreatePayment(ctx context.Context, req *models.Payment, event *models.PaymentEvent) (*models.GeneralResponse, error) {
+	return u.D.CreatePayment(ctx, req, event)
+}
+func (u *Database) GetPayment(ctx context.Context, req *models.GetUsersBookRequest) (*models.Payment, error) {
+	return u.D.GetPayment(ctx, req)
+}
+func (u *Database) UpdatePayment(ctx context.Context, req *models.UpdatePaymentRequest) (*models.GeneralResponse, error) {
+	return u.D.UpdatePayment(ctx, req)
+}
+func (u *Database) CreateSaga(ctx context.Context, req *models.Saga) (*models.GeneralResponse, error) {
+	return u.D.CreateSaga(ctx, req)
+}
+func (u *Database) UpdateSaga(ctx context.Context, req *models.Saga, step *models.SagaStep) (*models.GeneralResponse, error) {
+	return u.D.UpdateSaga(ctx, req, step)
+}
+func (u *Database) PendingSagas(ctx context.Context) ([]*models.Saga, error) {
+	return u.D.PendingSagas(ctx)
+}
+func (u *Database) SaveCommand(ctx context.Context, req *models.Command) (*models.GeneralResponse, error) {
+	return u.D.SaveCommand(ctx, req)
+}
+func (u *Database) GetCommand(ctx context.Context, req *models.GetCommandRequest) (*models.Command, error) {
+	return u.D.GetCommand(ctx, req)
+}
+func (u *Database) SaveProcessedKey(ctx context.Context, req *models.ProcessedKey) (*models.GeneralResponse, error) {
+	return u.D.SaveProcessedKey(ctx, req)
+}
+func (u *Database) GetProcessedKey(ctx context.Context, key string) (*models.ProcessedKey, error) {
+	return u.D.GetProcessedKey(ctx, key)
+}
+func (u *Database) LegacyHolds(ctx context.Context) ([]*models.RoomHold, error) {
+	return u.D.LegacyHolds(ctx)
+}
+func (u *Database) DeleteLegacyHold(ctx context.Context, req *models.RoomHold) (*models.GeneralResponse, error) {
+	return u.D.DeleteLegacyHold(ctx, req)
+}
 func (u *AdjustDatabase) Create(ctx context.Context, req *booking.BookHotelRequest) (*booking.GeneralResponse, error) {
 	return u.A.Create(ctx, req)
 }
@@ -54,6 +141,15 @@ func (u *AdjustDatabase) Update(ctx context.Context, req *booking.BookHotelUpdat
 func (u *AdjustDatabase) Cancel(ctx context.Context, req *booking.CancelROomRequest) (*booking.GeneralResponse, error) {
 	return u.A.Cancel(ctx, req)
 }
+func (u *AdjustDatabase) CheckIn(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	return u.A.CheckIn(ctx, req)
+}
+func (u *AdjustDatabase) CheckOut(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	return u.A.CheckOut(ctx, req)
+}
+func (u *AdjustDatabase) MarkNoShow(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	return u.A.MarkNoShow(ctx, req)
+}
 func (u *AdjustDatabase) CreateW(ctx context.Context, req *booking.CreateWaitingList) (*booking.GeneralResponse, error) {
 	return u.A.CreateW(ctx, req)
 }
@@ -66,3 +162,51 @@ func (u *AdjustDatabase) UpdateW(ctx context.Context, req *booking.UpdateWaiting
 func (u *AdjustDatabase) DeleteW(ctx context.Context, req *booking.DeleteWaitingList) (*booking.GeneralResponse, error) {
 	return u.A.DeleteW(ctx, req)
 }
+func (u *AdjustDatabase) CheckAvailability(ctx context.Context, req *booking.CheckAvailabilityRequest) (*booking.CheckAvailabilityResponse, error) {
+	return u.A.CheckAvailability(ctx, req)
+}
+func (u *AdjustDatabase) GetPayment(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.Payment, error) {
+	return u.A.GetPayment(ctx, req)
+}
+func (u *AdjustDatabase) Resume(ctx context.Context) error {
+	return u.A.Resume(ctx)
+}
+func (u *AdjustDatabase) ReleaseLegacyHolds(ctx context.Context) error {
+	return u.A.ReleaseLegacyHolds(ctx)
+}
+func (u *AdjustDatabase) AcceptOffer(ctx context.Context, req *booking.AcceptOfferRequest) (*booking.GeneralResponse, error) {
+	return u.A.AcceptOffer(ctx, req)
+}
+func (u *AdjustDatabase) ExpireOffers(ctx context.Context) {
+	u.A.ExpireOffers(ctx)
+}
+func (u *AdjustDatabase) AssignRooms(ctx context.Context) {
+	u.A.AssignRooms(ctx)
+}
+func (u *AdjustDatabase) SearchAvailability(ctx context.Context, req *booking.SearchAvailabilityRequest) (*booking.SearchAvailabilityResponse, error) {
+	return u.A.SearchAvailability(ctx, req)
+}
+func (u *AdjustDatabase) AssignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	return u.A.AssignRoom(ctx, req)
+}
+func (u *AdjustDatabase) ReassignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	return u.A.ReassignRoom(ctx, req)
+}
+func (u *AdjustDatabase) ListBookings(ctx context.Context, req *booking.ListRequest) (*booking.ListBookingsResponse, error) {
+	return u.A.ListBookings(ctx, req)
+}
+func (u *AdjustDatabase) ListWaitingList(ctx context.Context, req *booking.ListRequest) (*booking.ListWaitingListResponse, error) {
+	return u.A.ListWaitingList(ctx, req)
+}
+func (u *AdjustDatabase) Getall(ctx context.Context, req *booking.Request) (*booking.Response, error) {
+	return u.A.Getall(ctx, req)
+}
+func (u *AdjustDatabase) GetReport(ctx context.Context, req *booking.ReportRequest) (*booking.Report, error) {
+	return u.A.GetReport(ctx, req)
+}
+func (u *AdjustDatabase) GetOccupancyCalendar(ctx context.Context, req *booking.GetOccupancyCalendarRequest) (*booking.OccupancyCalendar, error) {
+	return u.A.GetOccupancyCalendar(ctx, req)
+}
+func (u *AdjustDatabase) GetCommandStatus(ctx context.Context, req *booking.GetCommandStatusRequest) (*booking.CommandStatus, error) {
+	return u.A.GetCommandStatus(ctx, req)
+}
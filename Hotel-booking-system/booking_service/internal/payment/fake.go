@@ -0,0 +1,112 @@
+package payment
+
+import (
+	"booking-service/models"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DeclineToken makes Fake decline an authorization, so failed payments can be
+// tried locally. Any token starting with it is declined.
+const DeclineToken = "decline"
+
+// Fake is an in-memory PaymentProvider for local runs and tests. It approves
+// every authorization except those made without a token or with DeclineToken
+// and checks that captures, refunds and voids are consistent with what was
+// authorized.
+// Authorizations live in memory and are forgotten on restart.
+type Fake struct {
+	mu    sync.Mutex
+	next  int
+	auths map[string]*fakeAuth
+}
+
+type fakeAuth struct {
+	amount   float32
+	captured float32
+	refunded float32
+	voided   bool
+}
+
+func NewFake() *Fake {
+	return &Fake{auths: make(map[string]*fakeAuth)}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) Authorize(ctx context.Context, req *AuthorizeRequest) (string, error) {
+	if req.Token == "" {
+		return "", models.ErrNoPaymentToken
+	}
+	if strings.HasPrefix(req.Token, DeclineToken) {
+		return "", models.ErrPaymentDeclined
+	}
+	if req.Amount <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	ref := fmt.Sprintf("fake_%d_%d", req.BookingID, f.next)
+	f.auths[ref] = &fakeAuth{amount: req.Amount}
+	return ref, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, ref string, amount float32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, err := f.auth(ref)
+	if err != nil {
+		return err
+	}
+	if auth.voided || auth.captured > 0 {
+		return fmt.Errorf("authorization %s cannot be captured", ref)
+	}
+	if amount > auth.amount {
+		return fmt.Errorf("cannot capture %.2f of %.2f authorized", amount, auth.amount)
+	}
+	auth.captured = amount
+	return nil
+}
+
+func (f *Fake) Refund(ctx context.Context, ref string, amount float32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, err := f.auth(ref)
+	if err != nil {
+		return err
+	}
+	if amount > auth.captured-auth.refunded {
+		return fmt.Errorf("cannot refund %.2f of %.2f captured", amount, auth.captured-auth.refunded)
+	}
+	auth.refunded += amount
+	return nil
+}
+
+func (f *Fake) Void(ctx context.Context, ref string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, err := f.auth(ref)
+	if err != nil {
+		return err
+	}
+	if auth.captured > 0 {
+		return fmt.Errorf("authorization %s is already captured", ref)
+	}
+	auth.voided = true
+	return nil
+}
+
+func (f *Fake) auth(ref string) (*fakeAuth, error) {
+	auth, ok := f.auths[ref]
+	if !ok {
+		return nil, fmt.Errorf("unknown authorization %s", ref)
+	}
+	return auth, nil
+}
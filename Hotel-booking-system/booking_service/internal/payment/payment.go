@@ -0,0 +1,27 @@
+// Package payment holds the payment gateways a booking can be charged through.
+//
+// A booking is authorized when it is made, captured at check-in, and voided,
+// partly captured or refunded when it is cancelled.
+package payment
+
+import "context"
+
+// AuthorizeRequest reserves Amount on the guest's payment method.
+type AuthorizeRequest struct {
+	BookingID int32
+	UserID    int32
+	Amount    float32
+	// Token identifies the guest's payment method at the provider.
+	Token string
+}
+
+// PaymentProvider is a payment gateway. Authorize returns the provider's
+// reference for the authorization, which the other calls take.
+// A declined payment is reported as models.ErrPaymentDeclined.
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, req *AuthorizeRequest) (string, error)
+	Capture(ctx context.Context, ref string, amount float32) error
+	Refund(ctx context.Context, ref string, amount float32) error
+	Void(ctx context.Context, ref string) error
+}
@@ -0,0 +1,184 @@
+// Package saga runs operations that span several services as a sequence of
+// steps, undoing the completed ones in reverse order when a step fails.
+//
+// The saga state and every executed step are persisted, so a saga cut short
+// by a restart is picked up again by Resume: a running saga continues with
+// the step it was at, a compensating one continues undoing. Steps may run
+// twice after a crash and should check the shared state before acting.
+package saga
+
+import (
+	"booking-service/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Step is one action of a saga. Compensate undoes Do and may be nil for
+// steps that change nothing, such as checks.
+type Step struct {
+	Name       string
+	Do         func(ctx context.Context) error
+	Compensate func(ctx context.Context) error
+}
+
+// Saga is a saga ready to run. State must be a pointer shared with the step
+// closures; it is saved as JSON after every step.
+type Saga struct {
+	Kind  string
+	State any
+	Steps []Step
+}
+
+type idKey struct{}
+
+// ID returns the ID of the saga a step runs in, so the step can tag what it
+// makes with it and find it again when it runs twice. Outside a saga it is 0.
+func ID(ctx context.Context) int32 {
+	id, _ := ctx.Value(idKey{}).(int32)
+	return id
+}
+
+// Builder rebuilds a saga of one kind from its saved state.
+type Builder func(state []byte) (*Saga, error)
+
+// Store persists sagas.
+type Store interface {
+	CreateSaga(ctx context.Context, req *models.Saga) (*models.GeneralResponse, error)
+	UpdateSaga(ctx context.Context, req *models.Saga, step *models.SagaStep) (*models.GeneralResponse, error)
+	PendingSagas(ctx context.Context) ([]*models.Saga, error)
+}
+
+type Orchestrator struct {
+	Store    Store
+	builders map[string]Builder
+}
+
+func NewOrchestrator(store Store) *Orchestrator {
+	return &Orchestrator{Store: store, builders: make(map[string]Builder)}
+}
+
+// Register sets how sagas of kind are rebuilt when they are resumed.
+func (o *Orchestrator) Register(kind string, b Builder) {
+	o.builders[kind] = b
+}
+
+// Run executes a new saga. If a step fails, the completed steps are
+// compensated and the step's error is returned.
+func (o *Orchestrator) Run(ctx context.Context, s *Saga) error {
+	state, err := json.Marshal(s.State)
+	if err != nil {
+		return err
+	}
+	rec := &models.Saga{Kind: s.Kind, Status: models.SagaRunning, State: state}
+	res, err := o.Store.CreateSaga(ctx, rec)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(res.Message)
+	if err != nil {
+		return err
+	}
+	rec.ID = int32(id)
+	return o.run(ctx, rec, s)
+}
+
+// Resume continues every saga that was interrupted before it finished.
+func (o *Orchestrator) Resume(ctx context.Context) error {
+	pending, err := o.Store.PendingSagas(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rec := range pending {
+		build, ok := o.builders[rec.Kind]
+		if !ok {
+			log.Printf("saga %v: unknown kind %q", rec.ID, rec.Kind)
+			continue
+		}
+		s, err := build(rec.State)
+		if err != nil {
+			log.Printf("saga %v: %v", rec.ID, err)
+			continue
+		}
+		if rec.Status == models.SagaCompensating {
+			err = o.compensate(ctx, rec, s, errors.New(rec.Error))
+		} else {
+			err = o.run(ctx, rec, s)
+		}
+		if err != nil {
+			log.Printf("saga %v: %v", rec.ID, err)
+		}
+	}
+	return nil
+}
+
+func (o *Orchestrator) run(ctx context.Context, rec *models.Saga, s *Saga) error {
+	ctx = context.WithValue(ctx, idKey{}, rec.ID)
+	for int(rec.Step) < len(s.Steps) {
+		step := s.Steps[rec.Step]
+		if err := step.Do(ctx); err != nil {
+			rec.Status = models.SagaCompensating
+			rec.Error = fmt.Sprintf("%s: %v", step.Name, err)
+			if err := o.save(ctx, rec, s, &models.SagaStep{Name: step.Name, Action: models.SagaActionDo, Error: err.Error()}); err != nil {
+				return err
+			}
+			return o.compensate(ctx, rec, s, err)
+		}
+
+		rec.Step++
+		if int(rec.Step) == len(s.Steps) {
+			rec.Status = models.SagaCompleted
+		}
+		if err := o.save(ctx, rec, s, &models.SagaStep{Name: step.Name, Action: models.SagaActionDo}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compensate undoes the completed steps, last first. A failed compensation
+// stops the saga as failed, leaving the rest for an operator.
+func (o *Orchestrator) compensate(ctx context.Context, rec *models.Saga, s *Saga, cause error) error {
+	ctx = context.WithValue(ctx, idKey{}, rec.ID)
+	for rec.Step > 0 {
+		step := s.Steps[rec.Step-1]
+		if step.Compensate != nil {
+			if err := step.Compensate(ctx); err != nil {
+				rec.Status = models.SagaFailed
+				rec.Error = fmt.Sprintf("%s: compensation failed: %v", step.Name, err)
+				if err := o.save(ctx, rec, s, &models.SagaStep{Name: step.Name, Action: models.SagaActionCompensate, Error: err.Error()}); err != nil {
+					log.Println(err)
+				}
+				return errors.Join(cause, err)
+			}
+		}
+
+		rec.Step--
+		if rec.Step == 0 {
+			rec.Status = models.SagaCompensated
+		}
+		if err := o.save(ctx, rec, s, &models.SagaStep{Name: step.Name, Action: models.SagaActionCompensate}); err != nil {
+			return errors.Join(cause, err)
+		}
+	}
+	if rec.Status != models.SagaCompensated {
+		rec.Status = models.SagaCompensated
+		if err := o.save(ctx, rec, s, nil); err != nil {
+			return errors.Join(cause, err)
+		}
+	}
+	return cause
+}
+
+func (o *Orchestrator) save(ctx context.Context, rec *models.Saga, s *Saga, step *models.SagaStep) error {
+	state, err := json.Marshal(s.State)
+	if err != nil {
+		return err
+	}
+	rec.State = state
+	_, err = o.Store.UpdateSaga(ctx, rec, step)
+	return err
+}
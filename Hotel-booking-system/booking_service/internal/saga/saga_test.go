@@ -0,0 +1,263 @@
+package saga
+
+import (
+	"booking-service/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// memStore keeps sagas in memory in place of the database.
+type memStore struct {
+	sagas map[int32]models.Saga
+	steps []models.SagaStep
+}
+
+func newMemStore(sagas ...models.Saga) *memStore {
+	s := &memStore{sagas: make(map[int32]models.Saga)}
+	for _, v := range sagas {
+		s.sagas[v.ID] = v
+	}
+	return s
+}
+
+func (s *memStore) CreateSaga(_ context.Context, req *models.Saga) (*models.GeneralResponse, error) {
+	id := int32(len(s.sagas) + 1)
+	rec := *req
+	rec.ID = id
+	s.sagas[id] = rec
+	return &models.GeneralResponse{Message: strconv.Itoa(int(id))}, nil
+}
+
+func (s *memStore) UpdateSaga(_ context.Context, req *models.Saga, step *models.SagaStep) (*models.GeneralResponse, error) {
+	s.sagas[req.ID] = *req
+	if step != nil {
+		v := *step
+		v.SagaID = req.ID
+		s.steps = append(s.steps, v)
+	}
+	return &models.GeneralResponse{Message: "ok"}, nil
+}
+
+func (s *memStore) PendingSagas(context.Context) ([]*models.Saga, error) {
+	var res []*models.Saga
+	for _, v := range s.sagas {
+		if v.Status == models.SagaRunning || v.Status == models.SagaCompensating {
+			rec := v
+			res = append(res, &rec)
+		}
+	}
+	return res, nil
+}
+
+var (
+	errDo   = errors.New("do failed")
+	errUndo = errors.New("undo failed")
+)
+
+type testState struct {
+	Done []string `json:"done"`
+}
+
+// script builds a three step saga a, b, c that logs its calls and the saga
+// IDs they ran in. Steps named in failDo and failUndo fail, the ones in
+// noUndo have no compensation.
+type script struct {
+	failDo, failUndo, noUndo string
+	calls                    []string
+	ids                      []int32
+}
+
+func (sc *script) build(state *testState) *Saga {
+	s := &Saga{Kind: "test", State: state}
+	for _, name := range []string{"a", "b", "c"} {
+		step := Step{
+			Name: name,
+			Do: func(ctx context.Context) error {
+				sc.calls = append(sc.calls, "do "+name)
+				sc.ids = append(sc.ids, ID(ctx))
+				if name == sc.failDo {
+					return errDo
+				}
+				state.Done = append(state.Done, name)
+				return nil
+			},
+		}
+		if name != sc.noUndo {
+			step.Compensate = func(ctx context.Context) error {
+				sc.calls = append(sc.calls, "undo "+name)
+				sc.ids = append(sc.ids, ID(ctx))
+				if name == sc.failUndo {
+					return errUndo
+				}
+				return nil
+			}
+		}
+		s.Steps = append(s.Steps, step)
+	}
+	return s
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		script script
+		calls  []string
+		status string
+		step   int32
+		errs   []error
+	}{
+		{
+			name:   "all steps succeed",
+			calls:  []string{"do a", "do b", "do c"},
+			status: models.SagaCompleted,
+			step:   3,
+		},
+		{
+			name:   "first step fails",
+			script: script{failDo: "a"},
+			calls:  []string{"do a"},
+			status: models.SagaCompensated,
+			errs:   []error{errDo},
+		},
+		{
+			name:   "failed step compensates the completed ones in reverse",
+			script: script{failDo: "c"},
+			calls:  []string{"do a", "do b", "do c", "undo b", "undo a"},
+			status: models.SagaCompensated,
+			errs:   []error{errDo},
+		},
+		{
+			name:   "steps without compensation are skipped",
+			script: script{failDo: "c", noUndo: "b"},
+			calls:  []string{"do a", "do b", "do c", "undo a"},
+			status: models.SagaCompensated,
+			errs:   []error{errDo},
+		},
+		{
+			name:   "failed compensation stops the saga",
+			script: script{failDo: "c", failUndo: "b"},
+			calls:  []string{"do a", "do b", "do c", "undo b"},
+			status: models.SagaFailed,
+			step:   2,
+			errs:   []error{errDo, errUndo},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore()
+			sc := tt.script
+			err := NewOrchestrator(store).Run(context.Background(), sc.build(&testState{}))
+
+			if len(tt.errs) == 0 && err != nil {
+				t.Fatalf("Run() = %v, want nil", err)
+			}
+			for _, want := range tt.errs {
+				if !errors.Is(err, want) {
+					t.Errorf("Run() = %v, want %v", err, want)
+				}
+			}
+			if !reflect.DeepEqual(sc.calls, tt.calls) {
+				t.Errorf("calls = %v, want %v", sc.calls, tt.calls)
+			}
+			for _, id := range sc.ids {
+				if id != 1 {
+					t.Errorf("step ran in saga %d, want 1", id)
+				}
+			}
+			rec := store.sagas[1]
+			if rec.Status != tt.status || rec.Step != tt.step {
+				t.Errorf("saga is %s at step %d, want %s at step %d", rec.Status, rec.Step, tt.status, tt.step)
+			}
+		})
+	}
+}
+
+func TestResume(t *testing.T) {
+	tests := []struct {
+		name   string
+		saga   models.Saga
+		script script
+		calls  []string
+		status string
+		step   int32
+		done   []string
+	}{
+		{
+			name:   "running saga continues at its step",
+			saga:   models.Saga{ID: 7, Kind: "test", Status: models.SagaRunning, Step: 1, State: []byte(`{"done":["a"]}`)},
+			calls:  []string{"do b", "do c"},
+			status: models.SagaCompleted,
+			step:   3,
+			done:   []string{"a", "b", "c"},
+		},
+		{
+			name:   "running saga compensates when a step fails",
+			saga:   models.Saga{ID: 7, Kind: "test", Status: models.SagaRunning, Step: 1, State: []byte(`{"done":["a"]}`)},
+			script: script{failDo: "b"},
+			calls:  []string{"do b", "undo a"},
+			status: models.SagaCompensated,
+			done:   []string{"a"},
+		},
+		{
+			name:   "compensating saga continues undoing",
+			saga:   models.Saga{ID: 7, Kind: "test", Status: models.SagaCompensating, Step: 2, State: []byte(`{"done":["a","b"]}`), Error: "c: do failed"},
+			calls:  []string{"undo b", "undo a"},
+			status: models.SagaCompensated,
+			done:   []string{"a", "b"},
+		},
+		{
+			name:   "unknown kind is left alone",
+			saga:   models.Saga{ID: 7, Kind: "other", Status: models.SagaRunning, Step: 1, State: []byte(`{"done":["a"]}`)},
+			status: models.SagaRunning,
+			step:   1,
+			done:   []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore(tt.saga)
+			sc := tt.script
+			o := NewOrchestrator(store)
+			o.Register("test", func(state []byte) (*Saga, error) {
+				var s testState
+				if err := json.Unmarshal(state, &s); err != nil {
+					return nil, err
+				}
+				return sc.build(&s), nil
+			})
+			if err := o.Resume(context.Background()); err != nil {
+				t.Fatalf("Resume() = %v", err)
+			}
+
+			if !reflect.DeepEqual(sc.calls, tt.calls) {
+				t.Errorf("calls = %v, want %v", sc.calls, tt.calls)
+			}
+			for _, id := range sc.ids {
+				if id != tt.saga.ID {
+					t.Errorf("step ran in saga %d, want %d", id, tt.saga.ID)
+				}
+			}
+			rec := store.sagas[tt.saga.ID]
+			if rec.Status != tt.status || rec.Step != tt.step {
+				t.Errorf("saga is %s at step %d, want %s at step %d", rec.Status, rec.Step, tt.status, tt.step)
+			}
+			var state testState
+			if err := json.Unmarshal(rec.State, &state); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(state.Done, tt.done) {
+				t.Errorf("saved state = %v, want %v", state.Done, tt.done)
+			}
+		})
+	}
+}
+
+func TestIDOutsideSaga(t *testing.T) {
+	if id := ID(context.Background()); id != 0 {
+		t.Errorf("ID() = %d, want 0", id)
+	}
+}
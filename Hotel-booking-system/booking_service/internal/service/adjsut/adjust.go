@@ -2,6 +2,10 @@ package adjsut
 
 import (
 	interfaceservices "booking-service/internal/interface/services"
+	"booking-service/internal/payment"
+	"booking-service/internal/saga"
+	"booking-service/internal/service/cancellation"
+	"booking-service/internal/service/lifecycle"
 	"booking-service/models"
 	"booking-service/pkg/protos/booking"
 	"booking-service/pkg/protos/hotel"
@@ -11,6 +15,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,33 +27,46 @@ type Adjust struct {
 	Hotel hotel.HotelClient
 	S     *interfaceservices.Database
 	N     notificationss.NotificationClient
+	P     payment.PaymentProvider
+	Saga  *saga.Orchestrator
 }
 
-var userID int
-
-// Create обрабатывает запрос на создание бронирования
+// Create обрабатывает запрос на создание бронирования. Бронирование идёт
+// сагой: при ошибке на любом шаге выполненные шаги откатываются.
 func (u *Adjust) Create(ctx context.Context, req *booking.BookHotelRequest) (*booking.GeneralResponse, error) {
-	email, err := u.CheckUser(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = u.CheckHotel(ctx, req)
-	if err != nil {
-		if errors.Is(err, models.ErrHotelNotFound) || errors.Is(err, models.ErrRoomNotFound) {
-			_, notifyErr := u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: req.UserID, Message: err.Error()})
-			if notifyErr != nil {
-				log.Println(notifyErr)
-			}
+	state := &bookingState{Request: models.BookHotelRequest{
+		UserID:         req.UserID,
+		HotelID:        req.HotelID,
+		RoomID:         req.RoomId,
+		RoomType:       req.RoomType,
+		CheckInDate:    req.CheckInDate.AsTime(),
+		CheckOutDate:   req.CheckOutDate.AsTime(),
+		AssignLater:    req.AssignLater,
+		PreferredFloor: req.PreferredFloor,
+		RoomAmenities:  req.RoomAmenities,
+	}, Token: req.PaymentToken}
+	if err := u.Saga.Run(ctx, u.bookingSaga(state)); err != nil {
+		// Пользователь не прошёл проверку, CheckUser уже уведомил его
+		if state.Email == "" {
 			return nil, err
 		}
+		// Номер занят, в том числе параллельным запросом
+		if errors.Is(err, models.ErrRoomNotAvailable) || errors.Is(err, models.ErrRoomBooked) {
+			return u.handleWaitingList(ctx, req, state.Email)
+		}
 
-		if errors.Is(err, models.ErrRoomNotAvailable) {
-			return u.handleWaitingList(ctx, req, email)
+		message := err.Error()
+		if errors.Is(err, models.ErrPaymentDeclined) {
+			message = fmt.Sprintf("Your payment was declined, booking %v has been cancelled", state.BookingID)
 		}
+		_, notifyErr := u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: req.UserID, Message: message})
+		if notifyErr != nil {
+			log.Println(notifyErr)
+		}
+		return nil, err
 	}
 
-	return u.processBooking(ctx, req, email)
+	return &booking.GeneralResponse{Message: strconv.Itoa(int(state.BookingID))}, nil
 }
 
 // handleWaitingList обрабатывает добавление в список ожидания
@@ -66,7 +85,6 @@ func (u *Adjust) handleWaitingList(ctx context.Context, req *booking.BookHotelRe
 		return nil, err
 	}
 
-	userID = int(newReq.UserID)
 	_, notifyErr := u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: req.UserID, Message: res.Message})
 	if notifyErr != nil {
 		log.Println(notifyErr)
@@ -74,132 +92,351 @@ func (u *Adjust) handleWaitingList(ctx context.Context, req *booking.BookHotelRe
 	return &booking.GeneralResponse{Message: res.Message}, nil
 }
 
-// processBooking обрабатывает успешное бронирование
-func (u *Adjust) processBooking(ctx context.Context, req *booking.BookHotelRequest, email string) (*booking.GeneralResponse, error) {
-	res1, err := u.Hotel.Get(ctx, &hotel.GetroomRequest{HotelId: req.HotelID, Id: req.RoomId})
+// sendNotifications отправляет уведомления пользователю
+func (u *Adjust) sendNotifications(ctx context.Context, email, message string, userID int32) error {
+	_, err := u.N.Email(ctx, &notificationss.EmailSend{Email: email, Message: fmt.Sprintf("Congratulations on successfully booking your room! Your booking ID is %v", message)})
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return err
 	}
 
-	newReq := models.BookHotelRequest{
-		UserID:       req.UserID,
-		RoomID:       req.RoomId,
-		RoomType:     req.RoomType,
-		HotelID:      req.HotelID,
-		CheckInDate:  req.CheckInDate.AsTime(),
-		CheckOutDate: req.CheckOutDate.AsTime(),
-	}
-	res, err := u.S.Create(ctx, &newReq, float64(res1.PricePerNight))
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: userID, Message: fmt.Sprintf("Congratulations on successfully booking your room! Your booking ID is %v", message)})
+	return err
+}
+
+// Get обрабатывает запрос на получение информации о бронировании
+func (u *Adjust) Get(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GetUsersBookResponse, error) {
+	res, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-
-	if err := u.updateRoomAvailability(ctx, req); err != nil {
+	nights, err := u.S.Nights(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
 		return nil, err
 	}
-
-	if err := u.sendNotifications(ctx, email, res.Message, req.UserID); err != nil {
-		return nil, err
+	out := bookingResponse(res)
+	for _, v := range nights {
+		out.Nights = append(out.Nights, &booking.BookedNight{Date: timestamppb.New(v.Date), Price: v.Price})
 	}
-
-	userID = int(newReq.UserID)
-	return &booking.GeneralResponse{Message: res.Message}, nil
+	return out, nil
 }
 
-// updateRoomAvailability обновляет доступность номера
-func (u *Adjust) updateRoomAvailability(ctx context.Context, req *booking.BookHotelRequest) error {
-	_, err := u.Hotel.UpdateRoom(ctx, &hotel.UpdateRoomRequest{Available: false, HotelId: req.HotelID, Id: req.RoomId})
-	return err
+// bookingResponse переводит бронь в ответ gRPC, без ночей
+func bookingResponse(res *models.GetUsersBookResponse) *booking.GetUsersBookResponse {
+	out := &booking.GetUsersBookResponse{
+		Id:             res.ID,
+		UserID:         res.UserID,
+		HotelID:        res.HotelID,
+		RoomId:         res.RoomID,
+		RoomType:       res.RoomType,
+		CheckInDate:    timestamppb.New(res.CheckInDate),
+		CheckOutDate:   timestamppb.New(res.CheckOutDate),
+		TotalAmount:    res.TotalAmount,
+		Status:         res.Status,
+		CancelReason:   res.CancelReason,
+		RefundAmount:   res.RefundAmount,
+		PenaltyAmount:  res.PenaltyAmount,
+		Discount:       res.Discount,
+		PreferredFloor: res.PreferredFloor,
+		RoomAmenities:  res.RoomAmenities,
+	}
+	if !res.CancelledAt.IsZero() {
+		out.CancelledAt = timestamppb.New(res.CancelledAt)
+	}
+	return out
 }
 
-// sendNotifications отправляет уведомления пользователю
-func (u *Adjust) sendNotifications(ctx context.Context, email, message string, userID int64) error {
-	_, err := u.N.Email(ctx, &notificationss.EmailSend{Email: email, Message: fmt.Sprintf("Congratulations on successfully booking your room! Your booking ID is %v", message)})
+// Update обрабатывает запрос на обновление бронирования
+func (u *Adjust) Update(ctx context.Context, req *booking.BookHotelUpdateRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
+	}
+	if !lifecycle.Editable(info.Status) {
+		return nil, fmt.Errorf("%w: a %s booking cannot be changed", models.ErrStatusTransition, info.Status)
 	}
 
-	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: userID, Message: fmt.Sprintf("Congratulations on successfully booking your room! Your booking ID is %v", message)})
-	return err
-}
+	roomID, roomType := info.RoomID, info.RoomType
+	checkIn, checkOut := info.CheckInDate, info.CheckOutDate
+	if req.RoomId != 0 {
+		roomID = req.RoomId
+	}
+	if req.RoomType != "" {
+		roomType = req.RoomType
+	}
+	if req.CheckInDate != nil {
+		checkIn = req.CheckInDate.AsTime()
+	}
+	if req.CheckOutDate != nil {
+		checkOut = req.CheckOutDate.AsTime()
+	}
 
-// Get обрабатывает запрос на получение информации о бронировании
-func (u *Adjust) Get(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GetUsersBookResponse, error) {
-	res, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if !checkOut.After(checkIn) {
+		return nil, models.ErrInvalidStay
+	}
+	candidates, err := u.rooms(ctx, info.HotelID, roomType)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &booking.GetUsersBookResponse{
-		Id:           res.ID,
-		UserID:       res.UserID,
-		HotelID:      res.HotelID,
-		RoomId:       res.RoomID,
-		RoomType:     res.RoomType,
-		CheckInDate:  timestamppb.New(res.CheckInDate),
-		CheckOutDate: timestamppb.New(res.CheckOutDate),
-		TotalAmount:  res.TotalAmount,
-		Status:       res.Status,
-	}, nil
-}
-
-// Update обрабатывает запрос на обновление бронирования
-func (u *Adjust) Update(ctx context.Context, req *booking.BookHotelUpdateRequest) (*booking.GeneralResponse, error) {
-	res1, err := u.Hotel.Get(ctx, &hotel.GetroomRequest{HotelId: int32(hotelid), Id: req.RoomId})
+	// Сама бронь не должна мешать переносу на пересекающиеся даты
+	free, err := u.bookable(ctx, info.HotelID, candidates, checkIn, checkOut, req.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	// Бронь без номера остаётся без номера, ей нужен лишь свободный номер типа
+	var room *hotel.UpdateRoomRequest
+	for _, v := range free {
+		if v.Id == roomID || roomID == 0 {
+			room = v
+			break
+		}
+	}
+	if room == nil {
+		return nil, models.ErrRoomNotAvailable
+	}
+	// Цена пересчитывается по текущим тарифам, ночи брони перезаписываются
+	quote, err := u.quote(ctx, info.HotelID, roomID, room.RoomType, checkIn, checkOut)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
+	// Если новая цена выше авторизованной суммы, нужна новая авторизация,
+	// старая снимается только после успешного обновления
+	current, err := u.currentPayment(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	var replacement *models.Payment
+	if current != nil && current.Status == models.PaymentAuthorized && quote.Total > current.Amount {
+		if req.PaymentToken == "" {
+			return nil, models.ErrPaymentRequired
+		}
+		replacement, err = u.authorize(ctx, req.Id, info.UserID, req.PaymentToken, quote.Total)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	res, err := u.S.Update(ctx, &models.BookHotelUpdateRequest{
 		ID:           req.Id,
 		RoomID:       req.RoomId,
 		RoomType:     req.RoomType,
-		CheckInDate:  req.CheckInDate,
-		CheckOutDate: req.CheckOutDate,
-	}, float64(res1.PricePerNight))
+		CheckInDate:  timestamppb.New(checkIn),
+		CheckOutDate: timestamppb.New(checkOut),
+		Inventory:    roomIDs(candidates, room.RoomType),
+	}, quote)
 	if err != nil {
 		log.Println(err)
+		if replacement != nil {
+			if err := u.void(ctx, replacement); err != nil {
+				log.Println(err)
+			}
+		}
 		return nil, err
 	}
+	if replacement != nil {
+		if err := u.void(ctx, current); err != nil {
+			log.Println(err)
+		}
+	}
 
-	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: int32(userID), Message: "Your room info was successfully updated"})
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: info.UserID, Message: "Your room info was successfully updated"})
 	if err != nil {
 		log.Println(err)
 	}
 	return &booking.GeneralResponse{Message: res.Message}, nil
 }
 
-// Cancel обрабатывает запрос на отмену бронирования
+// Cancel отменяет бронирование, не удаляя его: по политике отеля считаются
+// возврат и штраф, они сохраняются в брони и отправляются гостю
 func (u *Adjust) Cancel(ctx context.Context, req *booking.CancelROomRequest) (*booking.GeneralResponse, error) {
 	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
 	if err != nil {
 		log.Println("info", err)
 		return nil, err
 	}
+	if err := lifecycle.Check(info.Status, models.StatusCancelled); err != nil {
+		return nil, err
+	}
 
-	_, err = u.Hotel.UpdateRoom(ctx, &hotel.UpdateRoomRequest{Available: true, HotelId: info.HotelID, Id: info.RoomID})
+	refund, penalty, err := u.charges(ctx, info)
 	if err != nil {
-		log.Println("Error updating room availability:", err)
 		return nil, err
 	}
 
-	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: int32(userID), Message: "You successfully cancelled the room"})
+	res, err := u.S.Cancel(ctx, &models.CancelRoomRequest{
+		ID:            req.Id,
+		Reason:        req.Reason,
+		From:          info.Status,
+		RefundAmount:  refund,
+		PenaltyAmount: penalty,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	// Отмена уже сохранена, ошибка расчёта с провайдером только логируется
+	if err := u.settle(ctx, info.ID, penalty); err != nil {
+		log.Println(err)
+	}
+
+	message := fmt.Sprintf("Your booking %v has been cancelled. Refund: %.2f, cancellation fee: %.2f", info.ID, refund, penalty)
+	// Письмо не обязательно: отмена уже сохранена, уведомление в приложении всё равно уйдёт
+	if guest, err := u.User.GetUser(ctx, &user.GetUserRequest{Id: info.UserID}); err != nil {
+		log.Println(err)
+	} else if _, err := u.N.Email(ctx, &notificationss.EmailSend{Email: guest.Email, Message: message}); err != nil {
+		log.Println(err)
+	}
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: info.UserID, Message: message})
 	if err != nil {
 		log.Println("Notification error:", err)
 	}
 
-	res, err := u.S.Cancel(ctx, &models.CancelRoomRequest{ID: req.Id})
+	u.promote(ctx, info.HotelID, info.RoomType, info.CheckInDate, info.CheckOutDate)
+	return &booking.GeneralResponse{Message: res.Message}, nil
+}
+
+// charges считает возврат и штраф брони по политике отмены отеля
+func (u *Adjust) charges(ctx context.Context, info *models.GetUsersBookResponse) (float32, float32, error) {
+	policy, err := u.Hotel.GetCancellationPolicy(ctx, &hotel.GetHotelRequest{Id: info.HotelID})
+	if err != nil {
+		log.Println(err)
+		return 0, 0, err
+	}
+	nights, err := u.S.Nights(ctx, &models.GetUsersBookRequest{ID: info.ID})
+	if err != nil {
+		log.Println(err)
+		return 0, 0, err
+	}
+	refund, penalty := cancellation.Evaluate(policy, info.CheckInDate, info.CheckOutDate, nights, info.TotalAmount, time.Now())
+	return refund, penalty, nil
+}
+
+// CheckIn заселяет гостя, не раньше даты заезда
+func (u *Adjust) CheckIn(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if today().Before(info.CheckInDate) {
+		return nil, models.ErrTooEarly
+	}
+	if err := lifecycle.Check(info.Status, models.StatusCheckedIn); err != nil {
+		return nil, err
+	}
+	// Бронь без номера получает лучший свободный номер своего типа
+	if info.RoomID == 0 {
+		if _, err := u.assign(ctx, info, 0, false); err != nil {
+			return nil, err
+		}
+	}
+	// Сначала сохраняется заселение, затем списывается авторизованная сумма:
+	// гость не должен платить за бронь, которая осталась confirmed
+	change := &models.UpdateStatusRequest{ID: info.ID, From: info.Status, To: models.StatusCheckedIn}
+	res, err := u.S.UpdateStatus(ctx, change)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if err := u.capture(ctx, info); err != nil {
+		// Списание не прошло, заселение откатывается мимо lifecycle.Check,
+		// обратного перехода в нём нет
+		revert := &models.UpdateStatusRequest{ID: info.ID, From: models.StatusCheckedIn, To: info.Status}
+		if _, revertErr := u.S.UpdateStatus(ctx, revert); revertErr != nil {
+			log.Printf("booking %v is checked in but its payment was not captured: %v", info.ID, revertErr)
+		}
+		return nil, err
+	}
+
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: info.UserID, Message: "You have checked in, enjoy your stay"})
+	if err != nil {
+		log.Println(err)
+	}
+	return &booking.GeneralResponse{Message: res.Message}, nil
+}
+
+// CheckOut выселяет гостя. При раннем выезде оставшиеся ночи освобождаются.
+func (u *Adjust) CheckOut(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	change := &models.UpdateStatusRequest{To: models.StatusCheckedOut}
+	if day := today(); day.After(info.CheckInDate) && day.Before(info.CheckOutDate) {
+		change.CheckOutDate = day
+	}
+	res, err := u.transition(ctx, info, change, "You have checked out, thank you for staying with us")
+	if err != nil {
+		return nil, err
+	}
+
+	// Ночи после раннего выезда можно предложить списку ожидания,
+	// при выезде в срок освобождать нечего
+	if !change.CheckOutDate.IsZero() {
+		u.promote(ctx, info.HotelID, info.RoomType, change.CheckOutDate, info.CheckOutDate)
+	}
+	return res, nil
+}
+
+// MarkNoShow отмечает, что гость не приехал, и освобождает номер
+func (u *Adjust) MarkNoShow(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	if today().Before(info.CheckInDate) {
+		return nil, models.ErrTooEarly
+	}
+	res, err := u.transition(ctx, info, &models.UpdateStatusRequest{To: models.StatusNoShow}, "Your booking was marked as a no-show")
+	if err != nil {
+		return nil, err
+	}
+
+	// Неявка оплачивается как поздняя отмена по политике отеля
+	if _, penalty, err := u.charges(ctx, info); err != nil {
+		log.Println(err)
+	} else if err := u.settle(ctx, info.ID, penalty); err != nil {
+		log.Println(err)
+	}
+
+	u.promote(ctx, info.HotelID, info.RoomType, info.CheckInDate, info.CheckOutDate)
+	return res, nil
+}
+
+// transition проверяет допустимость перехода и сохраняет новый статус брони
+func (u *Adjust) transition(ctx context.Context, info *models.GetUsersBookResponse, change *models.UpdateStatusRequest, message string) (*booking.GeneralResponse, error) {
+	if err := lifecycle.Check(info.Status, change.To); err != nil {
+		return nil, err
+	}
+	change.ID = info.ID
+	change.From = info.Status
+	res, err := u.S.UpdateStatus(ctx, change)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: info.UserID, Message: message})
+	if err != nil {
+		log.Println(err)
+	}
 	return &booking.GeneralResponse{Message: res.Message}, nil
 }
 
+// today возвращает текущую дату без времени, как она хранится в колонках DATE
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
 // CreateW обрабатывает запрос на создание записи в ожидании
 func (u *Adjust) CreateW(ctx context.Context, req *booking.CreateWaitingList) (*booking.GeneralResponse, error) {
 	newReq := models.CreateWaitingList{
@@ -216,7 +453,7 @@ func (u *Adjust) CreateW(ctx context.Context, req *booking.CreateWaitingList) (*
 		return nil, err
 	}
 
-	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: int32(userID), Message: "You have been added to the waiting list"})
+	_, err = u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: newReq.UserID, Message: "You have been added to the waiting list"})
 	if err != nil {
 		log.Println(err)
 	}
@@ -230,7 +467,12 @@ func (u *Adjust) GetW(ctx context.Context, req *booking.GetWaitinglistRequest) (
 		log.Println(err)
 		return nil, err
 	}
-	return &booking.GetWaitinglistResponse{
+	return waitingResponse(res), nil
+}
+
+// waitingResponse переводит запись листа ожидания в ответ gRPC
+func waitingResponse(res *models.GetWaitinglistResponse) *booking.GetWaitinglistResponse {
+	out := &booking.GetWaitinglistResponse{
 		UserId:       res.UserID,
 		UserEmail:    res.UserEmail,
 		RoomType:     res.RoomType,
@@ -239,7 +481,12 @@ func (u *Adjust) GetW(ctx context.Context, req *booking.GetWaitinglistRequest) (
 		CheckOutDate: timestamppb.New(res.CheckOutDate),
 		Status:       res.Status,
 		Id:           res.ID,
-	}, nil
+		BookingId:    res.BookingID,
+	}
+	if !res.OfferExpiresAt.IsZero() {
+		out.OfferExpiresAt = timestamppb.New(res.OfferExpiresAt)
+	}
+	return out
 }
 
 // UpdateW обрабатывает запрос на обновление записи в ожидании
@@ -259,13 +506,22 @@ func (u *Adjust) UpdateW(ctx context.Context, req *booking.UpdateWaitingListRequ
 	return &booking.GeneralResponse{Message: res.Message}, nil
 }
 
-// DeleteW обрабатывает запрос на удаление записи из ожидания
+// DeleteW обрабатывает запрос на удаление записи из ожидания. Если записи
+// предложен номер, он освобождается и предлагается следующему в очереди.
 func (u *Adjust) DeleteW(ctx context.Context, req *booking.DeleteWaitingList) (*booking.GeneralResponse, error) {
+	entry, err := u.S.GetW(ctx, &models.GetWaitinglistRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
 	res, err := u.S.DeleteW(ctx, &models.DeleteWaitingList{ID: req.Id})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	if entry.Status == models.WaitingStatusOffered {
+		u.withdraw(ctx, entry, "waiting list entry removed")
+	}
 	return &booking.GeneralResponse{Message: res.Message}, nil
 }
 
@@ -290,32 +546,234 @@ func (u *Adjust) CheckUser(ctx context.Context, req *booking.BookHotelRequest) (
 	return res.Email, nil
 }
 
-// CheckHotel проверяет наличие отеля и комнат
-func (u *Adjust) CheckHotel(ctx context.Context, req *booking.BookHotelRequest) (float64, error) {
-	res, err := u.Hotel.GetRooms(ctx, &hotel.GetroomRequest{HotelId: req.HotelID})
+// CheckHotel проверяет наличие отеля и свободного номера на весь период проживания
+// и возвращает цену проживания по ночам и все номера выбранного типа.
+// Если номер не указан, бронь получает первый свободный номер нужного типа.
+// С assign_later номер не выбирается: бронируется тип, номер назначается позже.
+func (u *Adjust) CheckHotel(ctx context.Context, req *booking.BookHotelRequest) (*models.Quote, []int32, error) {
+	if req.AssignLater && req.RoomType == "" {
+		return nil, nil, models.ErrRoomTypeRequired
+	}
+	checkIn, checkOut := req.CheckInDate.AsTime(), req.CheckOutDate.AsTime()
+	if !checkOut.After(checkIn) {
+		return nil, nil, models.ErrInvalidStay
+	}
+	candidates, err := u.rooms(ctx, req.HotelID, req.RoomType)
 	if err != nil {
-		log.Println(err)
-		return 0, models.ErrHotelNotFound
+		return nil, nil, err
+	}
+	free, err := u.bookable(ctx, req.HotelID, candidates, checkIn, checkOut, 0)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	checkInDate := req.CheckInDate.AsTime()
-	var availableRooms []*hotel.UpdateRoomRequest
-	for _, v := range res.Rooms {
-		if v.RoomType == req.RoomType {
-			roomInfo, err := u.S.GetRoomInfo(ctx, &models.GetRoomInfo{HotelID: req.HotelID})
-			if err != nil {
-				log.Println(err)
-				return 0, err
+	for _, v := range free {
+		if req.AssignLater || req.RoomId == 0 || v.Id == req.RoomId {
+			req.RoomId = v.Id
+			if req.AssignLater {
+				req.RoomId = 0
 			}
-			if roomInfo.CheckOutDate.Before(checkInDate) || roomInfo.CheckOutDate.Equal(checkInDate) {
-				availableRooms = append(availableRooms, v)
+			req.RoomType = v.RoomType
+			quote, err := u.quote(ctx, req.HotelID, req.RoomId, v.RoomType, checkIn, checkOut)
+			if err != nil {
+				return nil, nil, err
 			}
+			return quote, roomIDs(candidates, v.RoomType), nil
+		}
+	}
+	return nil, nil, models.ErrRoomNotAvailable
+}
+
+// quote запрашивает у сервиса отелей цену проживания по ночам в номере или,
+// если номер ещё не назначен, в номере типа roomType
+func (u *Adjust) quote(ctx context.Context, hotelID, roomID int32, roomType string, checkIn, checkOut time.Time) (*models.Quote, error) {
+	res, err := u.Hotel.Quote(ctx, &hotel.QuoteRequest{
+		HotelId:      hotelID,
+		RoomId:       roomID,
+		RoomType:     roomType,
+		CheckInDate:  timestamppb.New(checkIn),
+		CheckOutDate: timestamppb.New(checkOut),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	quote := &models.Quote{Subtotal: res.Subtotal, Discount: res.Discount, Total: res.Total}
+	for _, v := range res.Nights {
+		quote.Nights = append(quote.Nights, &models.NightPrice{Date: v.Date.AsTime(), Price: v.Price})
+	}
+	return quote, nil
+}
+
+// CheckAvailability возвращает номера отеля, свободные на весь период проживания
+func (u *Adjust) CheckAvailability(ctx context.Context, req *booking.CheckAvailabilityRequest) (*booking.CheckAvailabilityResponse, error) {
+	free, err := u.freeRooms(ctx, req.HotelId, req.RoomType, req.CheckInDate.AsTime(), req.CheckOutDate.AsTime(), 0)
+	if err != nil && !errors.Is(err, models.ErrRoomNotFound) {
+		log.Println(err)
+		return nil, err
+	}
+
+	var rooms []*booking.FreeRoom
+	for _, v := range free {
+		rooms = append(rooms, &booking.FreeRoom{
+			Id:            v.Id,
+			HotelId:       v.HotelId,
+			RoomType:      v.RoomType,
+			PricePerNight: v.PricePerNight,
+		})
+	}
+	return &booking.CheckAvailabilityResponse{Rooms: rooms}, nil
+}
+
+// freeRooms отбирает номера нужного типа (или все, если тип не задан),
+// которые можно забронировать на [checkIn, checkOut).
+// exclude позволяет не учитывать одну бронь, например при её переносе.
+func (u *Adjust) freeRooms(ctx context.Context, hotelID int32, roomType string, checkIn, checkOut time.Time, exclude int32) ([]*hotel.UpdateRoomRequest, error) {
+	if !checkOut.After(checkIn) {
+		return nil, models.ErrInvalidStay
+	}
+	candidates, err := u.rooms(ctx, hotelID, roomType)
+	if err != nil {
+		return nil, err
+	}
+	return u.bookable(ctx, hotelID, candidates, checkIn, checkOut, exclude)
+}
+
+// rooms возвращает номера отеля нужного типа (или все, если тип не задан)
+func (u *Adjust) rooms(ctx context.Context, hotelID int32, roomType string) ([]*hotel.UpdateRoomRequest, error) {
+	res, err := u.Hotel.GetRooms(ctx, &hotel.GetroomRequest{HotelId: hotelID})
+	if err != nil {
+		log.Println(err)
+		return nil, models.ErrHotelNotFound
+	}
+
+	var candidates []*hotel.UpdateRoomRequest
+	for _, v := range res.Rooms {
+		if roomType == "" || v.RoomType == roomType {
+			candidates = append(candidates, v)
 		}
 	}
+	if len(candidates) == 0 {
+		return nil, models.ErrRoomNotFound
+	}
+	return candidates, nil
+}
 
-	if len(availableRooms) > 0 {
-		return float64(availableRooms[0].PricePerNight), nil
+// ReleaseLegacyHolds один раз возвращает в продажу номера, которые старая
+// схема бронирования пометила недоступными в сервисе отелей. Номер, который
+// не удалось освободить, остаётся в списке до следующего запуска
+func (u *Adjust) ReleaseLegacyHolds(ctx context.Context) error {
+	holds, err := u.S.LegacyHolds(ctx)
+	if err != nil {
+		return err
+	}
+	for _, v := range holds {
+		if _, err := u.Hotel.UpdateRoom(ctx, &hotel.UpdateRoomRequest{Available: true, HotelId: v.HotelID, Id: v.RoomID}); err != nil {
+			log.Printf("room %v of hotel %v: %v", v.RoomID, v.HotelID, err)
+			continue
+		}
+		if _, err := u.S.DeleteLegacyHold(ctx, v); err != nil {
+			log.Println(err)
+		}
 	}
+	return nil
+}
 
-	return 0, models.ErrRoomNotAvailable
+// roomIDs возвращает номера rooms нужного типа
+func roomIDs(rooms []*hotel.UpdateRoomRequest, roomType string) []int32 {
+	var ids []int32
+	for _, v := range rooms {
+		if v.RoomType == roomType {
+			ids = append(ids, v.Id)
+		}
+	}
+	return ids
+}
+
+// bookable отбирает из candidates свободные номера. Брони без номера ждут
+// свободный номер своего типа, поэтому для них держатся последние свободные
+// номера каждого типа
+func (u *Adjust) bookable(ctx context.Context, hotelID int32, candidates []*hotel.UpdateRoomRequest, checkIn, checkOut time.Time, exclude int32) ([]*hotel.UpdateRoomRequest, error) {
+	vacant, err := u.vacantRooms(ctx, hotelID, candidates, checkIn, checkOut, exclude)
+	if err != nil {
+		return nil, err
+	}
+	loads, err := u.S.UnassignedLoad(ctx, &models.LoadRequest{
+		HotelIDs:     []int32{hotelID},
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+		ExcludeID:    exclude,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	spare := make(map[string]int32)
+	for _, v := range vacant {
+		spare[v.RoomType]++
+	}
+	for _, v := range loads {
+		spare[v.RoomType] -= v.Load
+	}
+	var free []*hotel.UpdateRoomRequest
+	for _, v := range vacant {
+		if spare[v.RoomType] > 0 {
+			free = append(free, v)
+			spare[v.RoomType]--
+		}
+	}
+	return free, nil
+}
+
+// vacantRooms отбирает из candidates номера, у которых нет брони,
+// пересекающейся с [checkIn, checkOut)
+func (u *Adjust) vacantRooms(ctx context.Context, hotelID int32, candidates []*hotel.UpdateRoomRequest, checkIn, checkOut time.Time, exclude int32) ([]*hotel.UpdateRoomRequest, error) {
+	var ids []int32
+	for _, v := range candidates {
+		ids = append(ids, v.Id)
+	}
+	booked, err := u.S.BookedRooms(ctx, &models.CheckAvailabilityRequest{
+		HotelID:      hotelID,
+		RoomIDs:      ids,
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+		ExcludeID:    exclude,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	busy := make(map[int32]bool, len(booked))
+	for _, id := range booked {
+		busy[id] = true
+	}
+
+	var free []*hotel.UpdateRoomRequest
+	for _, v := range candidates {
+		if !busy[v.Id] {
+			free = append(free, v)
+		}
+	}
+	return free, nil
+}
+
+// GetCommandStatus возвращает результат асинхронной команды, принятой через Kafka
+func (u *Adjust) GetCommandStatus(ctx context.Context, req *booking.GetCommandStatusRequest) (*booking.CommandStatus, error) {
+	res, err := u.S.GetCommand(ctx, &models.GetCommandRequest{ID: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &booking.CommandStatus{
+		Id:        res.ID,
+		Kind:      res.Kind,
+		UserId:    res.UserID,
+		Status:    res.Status,
+		EntityId:  res.EntityID,
+		Message:   res.Message,
+		Error:     res.Error,
+		CreatedAt: timestamppb.New(res.CreatedAt),
+		UpdatedAt: timestamppb.New(res.UpdatedAt),
+	}, nil
 }
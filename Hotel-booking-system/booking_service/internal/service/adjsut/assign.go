@@ -0,0 +1,279 @@
+package adjsut
+
+import (
+	"booking-service/internal/service/lifecycle"
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"booking-service/pkg/protos/hotel"
+	notificationss "booking-service/pkg/protos/notification"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	// assignAhead — за сколько до заезда бронь без номера получает номер
+	assignAhead = 3 * 24 * time.Hour
+	// assignInterval — как часто запускается назначение номеров
+	assignInterval = time.Hour
+	// openGap — разрыв в ночах, начиная с которого свободные ночи номера
+	// считаются свободным календарём, а не «дырой» между бронями
+	openGap = 14
+)
+
+// AssignRoom назначает номер брони, сделанной по типу номера.
+// Без room_id выбирается лучший свободный номер типа, см. rank
+func (u *Adjust) AssignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if info.RoomID != 0 || !lifecycle.Editable(info.Status) {
+		return nil, models.ErrRoomAssigned
+	}
+	room, err := u.assign(ctx, info, req.RoomId, false)
+	if err != nil {
+		return nil, err
+	}
+	return &booking.GeneralResponse{Message: u.notifyRoom(ctx, info, room)}, nil
+}
+
+// ReassignRoom переселяет бронь в другой номер отеля по решению ресепшена,
+// в том числе во время проживания. Номер может быть другого типа,
+// цена брони при этом не меняется
+func (u *Adjust) ReassignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rooms, err := u.rooms(ctx, info.HotelID, "")
+	if err != nil {
+		return nil, err
+	}
+	var room *hotel.UpdateRoomRequest
+	// Бронь без номера занимала место в своём типе
+	previousType := info.RoomType
+	for _, v := range rooms {
+		if v.Id == req.RoomId {
+			room = v
+		}
+		if v.Id == info.RoomID {
+			previousType = v.RoomType
+		}
+	}
+	if room == nil {
+		return nil, models.ErrRoomNotFound
+	}
+	_, err = u.S.ReassignRoom(ctx, &models.AssignRoomRequest{
+		ID:        info.ID,
+		RoomID:    room.Id,
+		RoomType:  room.RoomType,
+		Inventory: roomIDs(rooms, room.RoomType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Если бронь ушла в номер другого типа, в прежнем типе освободился номер
+	if previousType != room.RoomType {
+		u.promote(ctx, info.HotelID, previousType, info.CheckInDate, info.CheckOutDate)
+	}
+	return &booking.GeneralResponse{Message: u.notifyRoom(ctx, info, room)}, nil
+}
+
+// AssignRooms раз в assignInterval назначает номера броням без номера,
+// заезд по которым в ближайшие assignAhead, пока не отменён ctx
+func (u *Adjust) AssignRooms(ctx context.Context) {
+	u.assignRooms(ctx)
+	ticker := time.NewTicker(assignInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.assignRooms(ctx)
+		}
+	}
+}
+
+// assignRooms назначает номера по порядку заезда, длинные проживания
+// первыми: их труднее всего уместить. Номер, не подходящий гостю по
+// удобствам, не назначается, бронь ждёт ресепшена или заселения
+func (u *Adjust) assignRooms(ctx context.Context) {
+	bookings, err := u.S.Unassigned(ctx, &models.UnassignedRequest{Before: today().Add(assignAhead)})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, info := range bookings {
+		room, err := u.assign(ctx, info, 0, true)
+		if err != nil {
+			log.Printf("booking %v: %v", info.ID, err)
+			continue
+		}
+		u.notifyRoom(ctx, info, room)
+	}
+}
+
+// notifyRoom сообщает гостю номер, в котором он будет жить
+func (u *Adjust) notifyRoom(ctx context.Context, info *models.GetUsersBookResponse, room *hotel.UpdateRoomRequest) string {
+	message := fmt.Sprintf("Room %s has been assigned to your booking %v", room.RoomNumber, info.ID)
+	_, err := u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: info.UserID, Message: message})
+	if err != nil {
+		log.Println(err)
+	}
+	return message
+}
+
+// assign выбирает для брони без номера свободный номер её типа и сохраняет его.
+// Назначение не меняет число занятых номеров типа, поэтому можно взять и номер,
+// который держится для других броней без номера. Вся бронь получает один номер,
+// поэтому подходят только номера, свободные на все её ночи
+func (u *Adjust) assign(ctx context.Context, info *models.GetUsersBookResponse, roomID int32, strict bool) (*hotel.UpdateRoomRequest, error) {
+	candidates, err := u.rooms(ctx, info.HotelID, info.RoomType)
+	if err != nil {
+		return nil, err
+	}
+	vacant, err := u.vacantRooms(ctx, info.HotelID, candidates, info.CheckInDate, info.CheckOutDate, info.ID)
+	if err != nil {
+		return nil, err
+	}
+	if roomID == 0 {
+		if vacant, err = u.rank(ctx, info, vacant, strict); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range vacant {
+		if roomID != 0 && v.Id != roomID {
+			continue
+		}
+		_, err := u.S.AssignRoom(ctx, &models.AssignRoomRequest{
+			ID:        info.ID,
+			RoomID:    v.Id,
+			RoomType:  v.RoomType,
+			Inventory: roomIDs(candidates, v.RoomType),
+		})
+		// Номер успели занять параллельным запросом, пробуем следующий
+		if errors.Is(err, models.ErrRoomBooked) && roomID == 0 {
+			continue
+		}
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		return v, nil
+	}
+	return nil, models.ErrRoomNotAvailable
+}
+
+// rank упорядочивает свободные номера для брони: сначала номера со всеми
+// удобствами, которые просил гость, затем ближайшие к желаемому этажу,
+// затем те, что меньше всего дробят календарь номера. В strict номера без
+// нужных удобств отбрасываются
+func (u *Adjust) rank(ctx context.Context, info *models.GetUsersBookResponse, vacant []*hotel.UpdateRoomRequest, strict bool) ([]*hotel.UpdateRoomRequest, error) {
+	if len(vacant) == 0 {
+		return nil, models.ErrRoomNotAvailable
+	}
+	var ids []int32
+	for _, v := range vacant {
+		ids = append(ids, v.Id)
+	}
+	neighbours, err := u.S.Neighbours(ctx, &models.RoomNeighbours{
+		HotelID:      info.HotelID,
+		RoomIDs:      ids,
+		CheckInDate:  info.CheckInDate,
+		CheckOutDate: info.CheckOutDate,
+		ExcludeID:    info.ID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	around := make(map[int32]*models.Neighbours, len(neighbours))
+	for _, v := range neighbours {
+		around[v.RoomID] = v
+	}
+
+	type ranked struct {
+		room    *hotel.UpdateRoomRequest
+		matches bool
+		floor   int32
+		gaps    int
+	}
+	var list []ranked
+	for _, v := range vacant {
+		r := ranked{room: v, matches: hasAmenities(v.Amenities, info.RoomAmenities)}
+		if strict && !r.matches {
+			continue
+		}
+		if info.PreferredFloor != nil {
+			r.floor = v.GetFloor() - *info.PreferredFloor
+			if r.floor < 0 {
+				r.floor = -r.floor
+			}
+		}
+		n := around[v.Id]
+		if n == nil {
+			n = &models.Neighbours{}
+		}
+		r.gaps = gapCost(n.PrevEnd, info.CheckInDate) + gapCost(info.CheckOutDate, n.NextFrom)
+		list = append(list, r)
+	}
+	if len(list) == 0 {
+		return nil, models.ErrNoRoomMatch
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.matches != b.matches {
+			return a.matches
+		}
+		if a.floor != b.floor {
+			return a.floor < b.floor
+		}
+		if a.gaps != b.gaps {
+			return a.gaps < b.gaps
+		}
+		return a.room.Id < b.room.Id
+	})
+	res := make([]*hotel.UpdateRoomRequest, 0, len(list))
+	for _, v := range list {
+		res = append(res, v.room)
+	}
+	return res, nil
+}
+
+// gapCost оценивает свободные ночи между from и to. Бронь вплотную к
+// соседней ничего не стоит, короткая «дыра», которую трудно продать, стоит
+// дороже всего, свободный календарь (нет соседа или openGap ночей и больше) — 1
+func gapCost(from, to time.Time) int {
+	if from.IsZero() || to.IsZero() {
+		return 1
+	}
+	nights := int(to.Sub(from).Hours() / 24)
+	if nights <= 0 {
+		return 0
+	}
+	if nights >= openGap {
+		return 1
+	}
+	return openGap + 1 - nights
+}
+
+// hasAmenities проверяет, что у номера есть все нужные удобства
+func hasAmenities(room, wanted []string) bool {
+	have := make(map[string]bool, len(room))
+	for _, v := range room {
+		have[v] = true
+	}
+	for _, v := range wanted {
+		if !have[v] {
+			return false
+		}
+	}
+	return true
+}
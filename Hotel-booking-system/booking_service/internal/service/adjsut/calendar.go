@@ -0,0 +1,80 @@
+package adjsut
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"booking-service/pkg/protos/hotel"
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxCalendarDays — самый длинный период, который показывает календарь загрузки
+const maxCalendarDays = 92
+
+// GetOccupancyCalendar строит сетку загрузки отеля: строка на каждый номер,
+// столбец на каждую дату [from, to), в ячейке бронь, занимающая номер в эту ночь.
+// Брони без номера попадают только в список броней
+func (u *Adjust) GetOccupancyCalendar(ctx context.Context, req *booking.GetOccupancyCalendarRequest) (*booking.OccupancyCalendar, error) {
+	if req.From == nil || req.To == nil {
+		return nil, models.ErrInvalidRange
+	}
+	from := req.From.AsTime().UTC().Truncate(24 * time.Hour)
+	to := req.To.AsTime().UTC().Truncate(24 * time.Hour)
+	days := int(to.Sub(from).Hours() / 24)
+	if days < 1 || days > maxCalendarDays {
+		return nil, models.ErrInvalidRange
+	}
+
+	rooms, err := u.Hotel.GetRooms(ctx, &hotel.GetroomRequest{HotelId: req.HotelId})
+	if err != nil {
+		log.Println(err)
+		return nil, models.ErrHotelNotFound
+	}
+	bookings, err := u.S.HotelBookings(ctx, &models.CalendarRequest{HotelID: req.HotelId, From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &booking.OccupancyCalendar{HotelId: req.HotelId}
+	for i := 0; i < days; i++ {
+		res.Dates = append(res.Dates, timestamppb.New(from.AddDate(0, 0, i)))
+	}
+	rows := make(map[int32]*booking.CalendarRoom, len(rooms.Rooms))
+	for _, v := range rooms.Rooms {
+		row := &booking.CalendarRoom{
+			RoomId:     v.Id,
+			RoomNumber: v.RoomNumber,
+			RoomType:   v.RoomType,
+			Floor:      v.GetFloor(),
+			BookingIds: make([]int32, days),
+		}
+		rows[v.Id] = row
+		res.Rooms = append(res.Rooms, row)
+	}
+	for _, v := range bookings {
+		res.Bookings = append(res.Bookings, &booking.CalendarBooking{
+			Id:           v.ID,
+			UserId:       v.UserID,
+			RoomId:       v.RoomID,
+			RoomType:     v.RoomType,
+			CheckInDate:  timestamppb.New(v.CheckInDate),
+			CheckOutDate: timestamppb.New(v.CheckOutDate),
+			Status:       v.Status,
+		})
+		// Номер могли удалить из отеля, его брони остаются только в списке
+		row := rows[v.RoomID]
+		if row == nil {
+			continue
+		}
+		for i := range row.BookingIds {
+			night := from.AddDate(0, 0, i)
+			if !night.Before(v.CheckInDate) && night.Before(v.CheckOutDate) {
+				row.BookingIds[i] = v.ID
+			}
+		}
+	}
+	return res, nil
+}
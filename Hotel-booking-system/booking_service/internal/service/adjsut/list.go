@@ -0,0 +1,155 @@
+package adjsut
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	bookingStatuses = []string{
+		models.StatusPending, models.StatusConfirmed, models.StatusCheckedIn,
+		models.StatusCheckedOut, models.StatusCancelled, models.StatusNoShow,
+	}
+	waitingStatuses = []string{
+		models.WaitingStatusWaiting, models.WaitingStatusOffered,
+		models.WaitingStatusAccepted, models.WaitingStatusExpired,
+	}
+)
+
+// ListBookings отдаёт страницу броней по фильтрам. Страницы идут по курсору:
+// next_cursor указывает на последнюю отданную бронь, поэтому новые брони
+// не сдвигают и не повторяют уже прочитанные
+func (u *Adjust) ListBookings(ctx context.Context, req *booking.ListRequest) (*booking.ListBookingsResponse, error) {
+	filter, err := listRequest(req, bookingStatuses)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := u.S.ListBookings(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res := &booking.ListBookingsResponse{}
+	if len(rows) > int(filter.Limit) {
+		rows = rows[:filter.Limit]
+		last := rows[len(rows)-1]
+		res.NextCursor = encodeCursor(&models.Cursor{CheckInDate: last.CheckInDate, ID: last.ID})
+	}
+	for _, v := range rows {
+		res.Bookings = append(res.Bookings, bookingResponse(v))
+	}
+	return res, nil
+}
+
+// ListWaitingList отдаёт страницу листа ожидания, как ListBookings
+func (u *Adjust) ListWaitingList(ctx context.Context, req *booking.ListRequest) (*booking.ListWaitingListResponse, error) {
+	filter, err := listRequest(req, waitingStatuses)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := u.S.ListWaitingList(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res := &booking.ListWaitingListResponse{}
+	if len(rows) > int(filter.Limit) {
+		rows = rows[:filter.Limit]
+		last := rows[len(rows)-1]
+		res.NextCursor = encodeCursor(&models.Cursor{CheckInDate: last.CheckInDate, ID: last.ID})
+	}
+	for _, v := range rows {
+		res.Entries = append(res.Entries, waitingResponse(v))
+	}
+	return res, nil
+}
+
+// Getall отдаёт весь лист ожидания, проходя его страницами максимального размера
+func (u *Adjust) Getall(ctx context.Context, req *booking.Request) (*booking.Response, error) {
+	res := &booking.Response{}
+	page := &booking.ListRequest{Sort: models.SortOldest, Limit: maxPageSize}
+	for {
+		entries, err := u.ListWaitingList(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		res.Users = append(res.Users, entries.Entries...)
+		if entries.NextCursor == "" {
+			return res, nil
+		}
+		page.Cursor = entries.NextCursor
+	}
+}
+
+// listRequest проверяет фильтры списка и расшифровывает курсор
+func listRequest(req *booking.ListRequest, statuses []string) (*models.ListRequest, error) {
+	res := &models.ListRequest{
+		UserID:  req.UserId,
+		HotelID: req.HotelId,
+		Status:  req.Status,
+		Sort:    req.Sort,
+		Limit:   req.Limit,
+	}
+	if req.From != nil {
+		res.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		res.To = req.To.AsTime()
+	}
+	if !res.From.IsZero() && !res.To.IsZero() && !res.To.After(res.From) {
+		return nil, fmt.Errorf("%w: to must be after from", models.ErrInvalidList)
+	}
+	if res.Status != "" && !slices.Contains(statuses, res.Status) {
+		return nil, fmt.Errorf("%w: status must be one of %s", models.ErrInvalidList, strings.Join(statuses, ", "))
+	}
+	if res.Sort == "" {
+		res.Sort = models.SortCheckInAsc
+	}
+	switch res.Sort {
+	case models.SortCheckInAsc, models.SortCheckInDesc, models.SortNewest, models.SortOldest:
+	default:
+		return nil, fmt.Errorf("%w: %q", models.ErrInvalidSort, res.Sort)
+	}
+	if res.Limit < 1 {
+		res.Limit = defaultPageSize
+	}
+	if res.Limit > maxPageSize {
+		res.Limit = maxPageSize
+	}
+	if req.Cursor != "" {
+		after, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		res.After = after
+	}
+	return res, nil
+}
+
+// encodeCursor упаковывает дату заезда и ID в непрозрачную строку
+func encodeCursor(c *models.Cursor) string {
+	raw := fmt.Sprintf("%d:%d", c.CheckInDate.UnixMicro(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (*models.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", models.ErrInvalidList)
+	}
+	date, id, ok := strings.Cut(string(raw), ":")
+	micro, err1 := strconv.ParseInt(date, 10, 64)
+	n, err2 := strconv.ParseInt(id, 10, 32)
+	if !ok || err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", models.ErrInvalidList)
+	}
+	return &models.Cursor{CheckInDate: time.UnixMicro(micro).UTC(), ID: int32(n)}, nil
+}
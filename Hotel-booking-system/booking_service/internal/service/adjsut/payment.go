@@ -0,0 +1,178 @@
+package adjsut
+
+import (
+	"booking-service/internal/payment"
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPayment возвращает текущий платёж брони с историей его статусов
+func (u *Adjust) GetPayment(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.Payment, error) {
+	res, err := u.S.GetPayment(ctx, &models.GetUsersBookRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	out := &booking.Payment{
+		Id:             res.ID,
+		BookingId:      res.BookingID,
+		Provider:       res.Provider,
+		Status:         res.Status,
+		Amount:         res.Amount,
+		CapturedAmount: res.CapturedAmount,
+		RefundedAmount: res.RefundedAmount,
+	}
+	for _, v := range res.Events {
+		out.Events = append(out.Events, &booking.PaymentEvent{
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Amount:     v.Amount,
+			Error:      v.Error,
+			CreatedAt:  timestamppb.New(v.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+// authorize резервирует сумму у платёжного провайдера и сохраняет платёж.
+// Отказ тоже сохраняется, чтобы в истории были все попытки оплаты.
+func (u *Adjust) authorize(ctx context.Context, bookingID, userID int32, token string, amount float32) (*models.Payment, error) {
+	ref, authErr := u.P.Authorize(ctx, &payment.AuthorizeRequest{BookingID: bookingID, UserID: userID, Amount: amount, Token: token})
+	p := &models.Payment{BookingID: bookingID, Provider: u.P.Name(), ProviderRef: ref, Status: models.PaymentAuthorized, Amount: amount}
+	event := &models.PaymentEvent{ToStatus: models.PaymentAuthorized, Amount: amount}
+	if authErr != nil {
+		log.Println(authErr)
+		p.Status = models.PaymentDeclined
+		event.ToStatus = models.PaymentDeclined
+		event.Error = authErr.Error()
+	}
+
+	res, err := u.S.CreatePayment(ctx, p, event)
+	if err != nil {
+		log.Println(err)
+		if authErr == nil {
+			// Деньги зарезервированы, но платёж не сохранён: резерв снимается
+			if err := u.P.Void(ctx, ref); err != nil {
+				log.Println(err)
+			}
+			return nil, err
+		}
+	}
+	if authErr != nil {
+		if errors.Is(authErr, models.ErrPaymentDeclined) {
+			return nil, authErr
+		}
+		return nil, fmt.Errorf("%w: %v", models.ErrPaymentDeclined, authErr)
+	}
+
+	id, err := strconv.Atoi(res.Message)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	p.ID = int32(id)
+	return p, nil
+}
+
+// currentPayment возвращает платёж брони; nil, если брони создавались до появления оплаты
+func (u *Adjust) currentPayment(ctx context.Context, bookingID int32) (*models.Payment, error) {
+	p, err := u.S.GetPayment(ctx, &models.GetUsersBookRequest{ID: bookingID})
+	if errors.Is(err, models.ErrPaymentNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return p, nil
+}
+
+// capture списывает стоимость проживания при заселении
+func (u *Adjust) capture(ctx context.Context, info *models.GetUsersBookResponse) error {
+	p, err := u.currentPayment(ctx, info.ID)
+	if err != nil || p == nil || p.Status == models.PaymentCaptured {
+		return err
+	}
+	if p.Status != models.PaymentAuthorized {
+		return fmt.Errorf("booking payment is %s and cannot be charged", p.Status)
+	}
+
+	amount := min(info.TotalAmount, p.Amount)
+	if err := u.P.Capture(ctx, p.ProviderRef, amount); err != nil {
+		log.Println(err)
+		return err
+	}
+	_, err = u.S.UpdatePayment(ctx, &models.UpdatePaymentRequest{
+		ID:             p.ID,
+		From:           models.PaymentAuthorized,
+		To:             models.PaymentCaptured,
+		Amount:         amount,
+		CapturedAmount: amount,
+	})
+	return err
+}
+
+// void снимает резерв, который больше не нужен
+func (u *Adjust) void(ctx context.Context, p *models.Payment) error {
+	if err := u.P.Void(ctx, p.ProviderRef); err != nil {
+		log.Println(err)
+		return err
+	}
+	_, err := u.S.UpdatePayment(ctx, &models.UpdatePaymentRequest{ID: p.ID, From: models.PaymentAuthorized, To: models.PaymentVoided})
+	return err
+}
+
+// settle закрывает платёж отменённой брони: штраф удерживается,
+// остальное освобождается или возвращается гостю
+func (u *Adjust) settle(ctx context.Context, bookingID int32, penalty float32) error {
+	p, err := u.currentPayment(ctx, bookingID)
+	if err != nil || p == nil {
+		return err
+	}
+
+	switch p.Status {
+	case models.PaymentAuthorized:
+		if penalty <= 0 {
+			return u.void(ctx, p)
+		}
+		amount := min(penalty, p.Amount)
+		if err := u.P.Capture(ctx, p.ProviderRef, amount); err != nil {
+			log.Println(err)
+			return err
+		}
+		_, err = u.S.UpdatePayment(ctx, &models.UpdatePaymentRequest{
+			ID:             p.ID,
+			From:           models.PaymentAuthorized,
+			To:             models.PaymentCaptured,
+			Amount:         amount,
+			CapturedAmount: amount,
+		})
+		return err
+	case models.PaymentCaptured:
+		refund := p.CapturedAmount - p.RefundedAmount - penalty
+		if refund <= 0 {
+			return nil
+		}
+		if err := u.P.Refund(ctx, p.ProviderRef, refund); err != nil {
+			log.Println(err)
+			return err
+		}
+		_, err = u.S.UpdatePayment(ctx, &models.UpdatePaymentRequest{
+			ID:             p.ID,
+			From:           models.PaymentCaptured,
+			To:             models.PaymentRefunded,
+			Amount:         refund,
+			CapturedAmount: p.CapturedAmount,
+			RefundedAmount: p.RefundedAmount + refund,
+		})
+		return err
+	}
+	return nil
+}
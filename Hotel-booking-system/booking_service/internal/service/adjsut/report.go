@@ -0,0 +1,73 @@
+package adjsut
+
+import (
+	"booking-service/internal/service/report"
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"booking-service/pkg/protos/hotel"
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetReport считает загрузку, ADR, RevPAR, долю отмен и срок бронирования
+// отеля по дням, неделям или месяцам. Номерной фонд берётся у сервиса отелей
+func (u *Adjust) GetReport(ctx context.Context, req *booking.ReportRequest) (*booking.Report, error) {
+	if req.From == nil || req.To == nil {
+		return nil, models.ErrInvalidReport
+	}
+	if req.Period == "" {
+		req.Period = models.PeriodDay
+	}
+	periods, err := report.Periods(req.From.AsTime(), req.To.AsTime(), req.Period)
+	if err != nil {
+		return nil, err
+	}
+	filter := &models.ReportRequest{
+		HotelID:    req.HotelId,
+		From:       periods[0].Start,
+		To:         periods[len(periods)-1].End,
+		Period:     req.Period,
+		ByRoomType: req.ByRoomType,
+	}
+
+	rooms, err := u.Hotel.GetRooms(ctx, &hotel.GetroomRequest{HotelId: req.HotelId})
+	if err != nil {
+		log.Println(err)
+		return nil, models.ErrHotelNotFound
+	}
+	inventory := make(map[string]int32)
+	for _, v := range rooms.Rooms {
+		inventory[v.RoomType]++
+	}
+	sales, err := u.S.NightSales(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	arrivals, err := u.S.Arrivals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &booking.Report{HotelId: req.HotelId, Period: req.Period}
+	for _, v := range report.Build(periods, inventory, sales, arrivals, req.ByRoomType) {
+		res.Rows = append(res.Rows, &booking.ReportRow{
+			PeriodStart:         timestamppb.New(v.PeriodStart),
+			PeriodEnd:           timestamppb.New(v.PeriodEnd),
+			RoomType:            v.RoomType,
+			Rooms:               v.Rooms,
+			RoomNightsAvailable: v.RoomNightsAvailable,
+			RoomNightsSold:      v.RoomNightsSold,
+			OccupancyRate:       v.OccupancyRate,
+			Revenue:             v.Revenue,
+			Adr:                 v.ADR,
+			Revpar:              v.RevPAR,
+			Arrivals:            v.Arrivals,
+			Cancellations:       v.Cancellations,
+			CancellationRate:    v.CancellationRate,
+			AvgLeadTimeDays:     v.AvgLeadTimeDays,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package adjsut
+
+import (
+	"booking-service/internal/saga"
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const bookingSagaKind = "booking"
+
+// bookingState — общее состояние шагов саги бронирования, сохраняется после каждого шага
+type bookingState struct {
+	Request   models.BookHotelRequest `json:"request"`
+	Email     string                  `json:"email"`
+	Quote     *models.Quote           `json:"quote"`
+	BookingID int32                   `json:"booking_id"`
+	Payment   *models.Payment         `json:"payment"`
+	// Токен оплаты не сохраняется: если сервис перезапустился до авторизации,
+	// оплатить нечем, и бронь отменяется
+	Token string `json:"-"`
+}
+
+// RegisterSagas регистрирует саги, которые нужно продолжать после перезапуска
+func (u *Adjust) RegisterSagas() {
+	u.Saga.Register(bookingSagaKind, func(data []byte) (*saga.Saga, error) {
+		state := &bookingState{}
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, err
+		}
+		return u.bookingSaga(state), nil
+	})
+}
+
+// Resume продолжает саги, прерванные перезапуском сервиса
+func (u *Adjust) Resume(ctx context.Context) error {
+	return u.Saga.Resume(ctx)
+}
+
+// bookingSaga описывает бронирование по шагам. Если шаг не удался, бронь
+// отменяется (номер снова свободен), а авторизация оплаты снимается.
+// Шаги могут повториться после перезапуска, поэтому они проверяют состояние.
+func (u *Adjust) bookingSaga(s *bookingState) *saga.Saga {
+	return &saga.Saga{
+		Kind:  bookingSagaKind,
+		State: s,
+		Steps: []saga.Step{
+			{
+				Name: "check_user",
+				Do: func(ctx context.Context) error {
+					email, err := u.CheckUser(ctx, &booking.BookHotelRequest{UserID: s.Request.UserID})
+					s.Email = email
+					return err
+				},
+			},
+			{
+				Name: "quote",
+				Do: func(ctx context.Context) error {
+					req := &booking.BookHotelRequest{
+						HotelID:      s.Request.HotelID,
+						RoomId:       s.Request.RoomID,
+						RoomType:     s.Request.RoomType,
+						CheckInDate:  timestamppb.New(s.Request.CheckInDate),
+						CheckOutDate: timestamppb.New(s.Request.CheckOutDate),
+						AssignLater:  s.Request.AssignLater,
+					}
+					quote, inventory, err := u.CheckHotel(ctx, req)
+					if err != nil {
+						return err
+					}
+					s.Request.RoomID = req.RoomId
+					s.Request.RoomType = req.RoomType
+					s.Request.Inventory = inventory
+					s.Quote = quote
+					return nil
+				},
+			},
+			{
+				Name: "reserve",
+				Do: func(ctx context.Context) error {
+					if s.BookingID != 0 {
+						return nil
+					}
+					// Если сервис упал после создания брони, но до сохранения
+					// BookingID, Create вернёт уже созданную сагой бронь
+					s.Request.SagaID = saga.ID(ctx)
+					res, err := u.S.Create(ctx, &s.Request, s.Quote)
+					if err != nil {
+						return err
+					}
+					id, err := strconv.Atoi(res.Message)
+					if err != nil {
+						return err
+					}
+					s.BookingID = int32(id)
+					return nil
+				},
+				Compensate: func(ctx context.Context) error {
+					return u.release(ctx, s.BookingID, "booking could not be completed")
+				},
+			},
+			{
+				Name: "authorize_payment",
+				Do: func(ctx context.Context) error {
+					if s.Payment != nil {
+						return nil
+					}
+					if s.Token == "" {
+						return models.ErrNoPaymentToken
+					}
+					p, err := u.authorize(ctx, s.BookingID, s.Request.UserID, s.Token, s.Quote.Total)
+					if err != nil {
+						return err
+					}
+					s.Payment = p
+					return nil
+				},
+				Compensate: func(ctx context.Context) error {
+					if s.Payment == nil {
+						return nil
+					}
+					err := u.void(ctx, s.Payment)
+					if errors.Is(err, models.ErrPaymentChanged) {
+						return nil
+					}
+					return err
+				},
+			},
+			{
+				Name: "confirm",
+				Do: func(ctx context.Context) error {
+					_, err := u.S.UpdateStatus(ctx, &models.UpdateStatusRequest{ID: s.BookingID, From: models.StatusPending, To: models.StatusConfirmed})
+					if errors.Is(err, models.ErrStatusChanged) {
+						// Повтор после перезапуска: бронь уже могла быть подтверждена
+						info, getErr := u.S.Get(ctx, &models.GetUsersBookRequest{ID: s.BookingID})
+						if getErr == nil && info.Status == models.StatusConfirmed {
+							return nil
+						}
+					}
+					return err
+				},
+			},
+			{
+				Name: "notify",
+				Do: func(ctx context.Context) error {
+					// Бронь уже подтверждена, ошибка уведомления её не отменяет
+					if err := u.sendNotifications(ctx, s.Email, strconv.Itoa(int(s.BookingID)), s.Request.UserID); err != nil {
+						log.Println(err)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// release отменяет бронь, которую не удалось довести до конца, и освобождает номер
+func (u *Adjust) release(ctx context.Context, id int32, reason string) error {
+	if id == 0 {
+		return nil
+	}
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: id})
+	if err != nil {
+		return err
+	}
+	if info.Status == models.StatusCancelled {
+		return nil
+	}
+	_, err = u.S.Cancel(ctx, &models.CancelRoomRequest{ID: id, From: info.Status, Reason: reason})
+	return err
+}
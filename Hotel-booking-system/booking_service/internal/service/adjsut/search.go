@@ -0,0 +1,198 @@
+package adjsut
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	"booking-service/pkg/protos/hotel"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+)
+
+const (
+	SortPriceAsc   = "price_asc"
+	SortPriceDesc  = "price_desc"
+	SortRatingDesc = "rating_desc"
+
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// SearchAvailability ищет отели, в которых хотя бы один подходящий номер
+// свободен на весь период. Фильтры и цены за период считает сервис отелей,
+// здесь отбрасываются занятые номера, затем результат сортируется и делится на страницы.
+func (u *Adjust) SearchAvailability(ctx context.Context, req *booking.SearchAvailabilityRequest) (*booking.SearchAvailabilityResponse, error) {
+	if req.CheckInDate == nil || req.CheckOutDate == nil {
+		return nil, models.ErrInvalidStay
+	}
+	checkIn, checkOut := req.CheckInDate.AsTime(), req.CheckOutDate.AsTime()
+	if !checkOut.After(checkIn) {
+		return nil, models.ErrInvalidStay
+	}
+	if req.Sort == "" {
+		req.Sort = SortPriceAsc
+	}
+	if req.Sort != SortPriceAsc && req.Sort != SortPriceDesc && req.Sort != SortRatingDesc {
+		return nil, fmt.Errorf("%w: %q", models.ErrInvalidSort, req.Sort)
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = defaultPageSize
+	}
+	if req.PageSize > maxPageSize {
+		req.PageSize = maxPageSize
+	}
+
+	found, err := u.Hotel.SearchHotels(ctx, &hotel.SearchHotelsRequest{
+		Location:     req.Location,
+		RoomType:     req.RoomType,
+		Guests:       req.Guests,
+		MinRating:    req.MinRating,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+		CheckInDate:  req.CheckInDate,
+		CheckOutDate: req.CheckOutDate,
+		Amenities:    req.Amenities,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// Занятость всех найденных номеров проверяется одним запросом
+	var ids, hotelIDs []int32
+	for _, h := range found.Hotels {
+		hotelIDs = append(hotelIDs, h.Id)
+		for _, room := range h.Rooms {
+			ids = append(ids, room.Id)
+		}
+	}
+	busy := make(map[int32]bool)
+	if len(ids) > 0 {
+		booked, err := u.S.BookedRooms(ctx, &models.CheckAvailabilityRequest{RoomIDs: ids, CheckInDate: checkIn, CheckOutDate: checkOut})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		for _, id := range booked {
+			busy[id] = true
+		}
+	}
+	// Брони без номера занимают по номеру своего типа
+	held := make(map[typeKey]int32)
+	if len(hotelIDs) > 0 {
+		loads, err := u.S.UnassignedLoad(ctx, &models.LoadRequest{HotelIDs: hotelIDs, CheckInDate: checkIn, CheckOutDate: checkOut})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		for _, v := range loads {
+			held[typeKey{v.HotelID, v.RoomType}] = v.Load
+		}
+	}
+
+	// Фасеты считаются по всем подходящим отелям, а не по текущей странице:
+	// каждый отель учитывается один раз для удобства и для типа номера
+	amenityCounts := make(map[string]int32)
+	roomTypeCounts := make(map[string]int32)
+	var hotels []*booking.AvailableHotel
+	for _, h := range found.Hotels {
+		available := &booking.AvailableHotel{Id: h.Id, Name: h.Name, Location: h.Location, Rating: h.Rating, Address: h.Address}
+		amenities := make(map[string]bool)
+		roomTypes := make(map[string]bool)
+		spare := make(map[string]int32)
+		for _, room := range h.Rooms {
+			if !busy[room.Id] {
+				spare[room.RoomType]++
+			}
+		}
+		for roomType := range spare {
+			spare[roomType] -= held[typeKey{h.Id, roomType}]
+		}
+		for _, room := range h.Rooms {
+			if busy[room.Id] || spare[room.RoomType] <= 0 {
+				continue
+			}
+			spare[room.RoomType]--
+			if available.FreeRooms == 0 || room.NightlyPrice < available.LowestPrice {
+				available.LowestPrice = room.NightlyPrice
+			}
+			available.FreeRooms++
+			roomTypes[room.RoomType] = true
+			for _, v := range room.Amenities {
+				amenities[v] = true
+			}
+		}
+		if available.FreeRooms == 0 {
+			continue
+		}
+		hotels = append(hotels, available)
+		for _, v := range h.Amenities {
+			amenities[v] = true
+		}
+		for v := range amenities {
+			amenityCounts[v]++
+		}
+		for v := range roomTypes {
+			roomTypeCounts[v]++
+		}
+	}
+	sortHotels(hotels, req.Sort)
+
+	res := &booking.SearchAvailabilityResponse{
+		Total:          int32(len(hotels)),
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		AmenityFacets:  facets(amenityCounts),
+		RoomTypeFacets: facets(roomTypeCounts),
+	}
+	start := int(req.Page-1) * int(req.PageSize)
+	if start < len(hotels) {
+		end := min(start+int(req.PageSize), len(hotels))
+		res.Hotels = hotels[start:end]
+	}
+	return res, nil
+}
+
+// typeKey — тип номера конкретного отеля
+type typeKey struct {
+	hotelID  int32
+	roomType string
+}
+
+// sortHotels упорядочивает отели по цене или рейтингу, при равенстве — по id,
+// чтобы страницы не менялись между запросами
+func sortHotels(hotels []*booking.AvailableHotel, by string) {
+	sort.SliceStable(hotels, func(i, j int) bool {
+		a, b := hotels[i], hotels[j]
+		switch {
+		case by == SortPriceDesc && a.LowestPrice != b.LowestPrice:
+			return a.LowestPrice > b.LowestPrice
+		case by == SortRatingDesc && a.Rating != b.Rating:
+			return a.Rating > b.Rating
+		case by == SortRatingDesc && a.LowestPrice != b.LowestPrice:
+			return a.LowestPrice < b.LowestPrice
+		case by == SortPriceAsc && a.LowestPrice != b.LowestPrice:
+			return a.LowestPrice < b.LowestPrice
+		}
+		return a.Id < b.Id
+	})
+}
+
+// facets превращает счётчики в список: сначала самые частые значения, при равенстве — по алфавиту
+func facets(counts map[string]int32) []*booking.Facet {
+	var res []*booking.Facet
+	for v, n := range counts {
+		res = append(res, &booking.Facet{Value: v, Count: n})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Value < res[j].Value
+	})
+	return res
+}
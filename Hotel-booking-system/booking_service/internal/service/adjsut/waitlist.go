@@ -0,0 +1,206 @@
+package adjsut
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/booking"
+	notificationss "booking-service/pkg/protos/notification"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// offerTTL — сколько номер держится для пользователя из списка ожидания
+	offerTTL = 30 * time.Minute
+	// offerSweepInterval — как часто проверяются просроченные предложения
+	offerSweepInterval = time.Minute
+)
+
+// promote предлагает номер, освободившийся на [from, to), первому по очереди
+// подходящему пользователю из списка ожидания: тот же отель, тип номера и
+// пересекающиеся даты. Ошибки только логируются, освобождение номера уже сохранено.
+func (u *Adjust) promote(ctx context.Context, hotelID int32, roomType string, from, to time.Time) {
+	// Прошедшие ночи предлагать некому
+	if day := today(); from.Before(day) {
+		from = day
+	}
+	if !to.After(from) {
+		return
+	}
+
+	entries, err := u.S.MatchW(ctx, &models.WaitingMatchRequest{HotelID: hotelID, RoomType: roomType, CheckInDate: from, CheckOutDate: to})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, entry := range entries {
+		offered, err := u.offer(ctx, entry)
+		if err != nil {
+			log.Println("Waiting list offer error:", err)
+			continue
+		}
+		if offered {
+			return
+		}
+	}
+}
+
+// offer держит свободный номер для записи из списка ожидания, создавая бронь
+// в статусе pending, и отправляет пользователю предложение.
+// Возвращает false, если на даты записи свободного номера нет.
+func (u *Adjust) offer(ctx context.Context, entry *models.GetWaitinglistResponse) (bool, error) {
+	req := &booking.BookHotelRequest{
+		HotelID:      entry.HotelID,
+		RoomType:     entry.RoomType,
+		CheckInDate:  timestamppb.New(entry.CheckInDate),
+		CheckOutDate: timestamppb.New(entry.CheckOutDate),
+	}
+	quote, inventory, err := u.CheckHotel(ctx, req)
+	if errors.Is(err, models.ErrRoomNotAvailable) || errors.Is(err, models.ErrRoomNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	res, err := u.S.Create(ctx, &models.BookHotelRequest{
+		UserID:       entry.UserID,
+		HotelID:      entry.HotelID,
+		RoomID:       req.RoomId,
+		RoomType:     entry.RoomType,
+		CheckInDate:  entry.CheckInDate,
+		CheckOutDate: entry.CheckOutDate,
+		Inventory:    inventory,
+	}, quote)
+	// Номер успели занять параллельным запросом
+	if errors.Is(err, models.ErrRoomBooked) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	bookingID, err := strconv.Atoi(res.Message)
+	if err != nil {
+		return false, err
+	}
+
+	expiresAt := time.Now().Add(offerTTL)
+	if _, err := u.S.OfferW(ctx, &models.OfferRequest{ID: entry.ID, BookingID: int32(bookingID), ExpiresAt: expiresAt}); err != nil {
+		// Запись успели изменить или удалить, держать номер незачем
+		if err := u.release(ctx, int32(bookingID), "waiting list entry changed"); err != nil {
+			log.Println(err)
+		}
+		return false, err
+	}
+
+	message := fmt.Sprintf("A %s room is available at hotel %v from %s to %s for %.2f. It is held for you until %s, accept waiting list offer %v to book it",
+		entry.RoomType, entry.HotelID, entry.CheckInDate.Format(time.DateOnly), entry.CheckOutDate.Format(time.DateOnly),
+		quote.Total, expiresAt.UTC().Format(time.RFC3339), entry.ID)
+	u.notifyWaiting(ctx, entry, message)
+	return true, nil
+}
+
+// AcceptOffer подтверждает бронь, которую держали для записи из списка ожидания.
+// Оплата авторизуется до подтверждения; если она отклонена, предложение
+// остаётся в силе до истечения срока.
+func (u *Adjust) AcceptOffer(ctx context.Context, req *booking.AcceptOfferRequest) (*booking.GeneralResponse, error) {
+	entry, err := u.S.GetW(ctx, &models.GetWaitinglistRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if entry.Status != models.WaitingStatusOffered || !time.Now().Before(entry.OfferExpiresAt) {
+		return nil, models.ErrOfferNotOpen
+	}
+	info, err := u.S.Get(ctx, &models.GetUsersBookRequest{ID: entry.BookingID})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if info.Status != models.StatusPending {
+		return nil, models.ErrOfferNotOpen
+	}
+
+	payment, err := u.authorize(ctx, info.ID, info.UserID, req.PaymentToken, info.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	// Статус записи меняется первым: с ним конкурирует снятие просроченных предложений
+	if _, err := u.S.UpdateWaitingStatus(ctx, &models.WaitingStatusRequest{ID: entry.ID, From: models.WaitingStatusOffered, To: models.WaitingStatusAccepted}); err != nil {
+		if err := u.void(ctx, payment); err != nil {
+			log.Println(err)
+		}
+		if errors.Is(err, models.ErrWaitingChanged) {
+			return nil, models.ErrOfferNotOpen
+		}
+		return nil, err
+	}
+	if _, err := u.S.UpdateStatus(ctx, &models.UpdateStatusRequest{ID: info.ID, From: models.StatusPending, To: models.StatusConfirmed}); err != nil {
+		log.Println(err)
+		if err := u.void(ctx, payment); err != nil {
+			log.Println(err)
+		}
+		return nil, err
+	}
+
+	if err := u.sendNotifications(ctx, entry.UserEmail, strconv.Itoa(int(info.ID)), info.UserID); err != nil {
+		log.Println(err)
+	}
+	return &booking.GeneralResponse{Message: strconv.Itoa(int(info.ID))}, nil
+}
+
+// ExpireOffers раз в offerSweepInterval снимает просроченные предложения
+// и передаёт номер следующему в очереди, пока не отменён ctx
+func (u *Adjust) ExpireOffers(ctx context.Context) {
+	ticker := time.NewTicker(offerSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.expireOffers(ctx)
+		}
+	}
+}
+
+func (u *Adjust) expireOffers(ctx context.Context) {
+	entries, err := u.S.ExpiredOffers(ctx)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, entry := range entries {
+		// Предложение могли принять, пока шла проверка
+		if _, err := u.S.UpdateWaitingStatus(ctx, &models.WaitingStatusRequest{ID: entry.ID, From: models.WaitingStatusOffered, To: models.WaitingStatusExpired}); err != nil {
+			log.Println(err)
+			continue
+		}
+		u.withdraw(ctx, entry, "waiting list offer expired")
+		u.notifyWaiting(ctx, entry, fmt.Sprintf("Your offer for waiting list entry %v has expired", entry.ID))
+	}
+}
+
+// withdraw освобождает номер, который держали для записи, и предлагает его следующему
+func (u *Adjust) withdraw(ctx context.Context, entry *models.GetWaitinglistResponse, reason string) {
+	if err := u.release(ctx, entry.BookingID, reason); err != nil {
+		log.Println(err)
+		return
+	}
+	u.promote(ctx, entry.HotelID, entry.RoomType, entry.CheckInDate, entry.CheckOutDate)
+}
+
+// notifyWaiting отправляет пользователю из списка ожидания письмо и уведомление
+func (u *Adjust) notifyWaiting(ctx context.Context, entry *models.GetWaitinglistResponse, message string) {
+	if _, err := u.N.Email(ctx, &notificationss.EmailSend{Email: entry.UserEmail, Message: message}); err != nil {
+		log.Println(err)
+	}
+	if _, err := u.N.Notification(ctx, &notificationss.ProduceMessage{UserId: entry.UserID, Message: message}); err != nil {
+		log.Println(err)
+	}
+}
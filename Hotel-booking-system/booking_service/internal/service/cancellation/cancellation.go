@@ -0,0 +1,44 @@
+// Package cancellation applies a hotel's cancellation policy to a booking.
+package cancellation
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/hotel"
+	"math"
+	"time"
+)
+
+// Evaluate splits the booking total into the part refunded to the guest and
+// the penalty kept by the hotel when the booking is cancelled at now.
+// Cancelling at least FreeCancellationHours before the check-in date is free;
+// later cancellations are charged the first PenaltyNights nights, at most the
+// whole stay. Nights are charged at their stored price less the booking's
+// share of any discount; bookings without stored nights use the average price.
+func Evaluate(policy *hotel.CancellationPolicy, checkIn, checkOut time.Time, nights []*models.NightPrice, total float32, now time.Time) (refund, penalty float32) {
+	deadline := checkIn.Add(-time.Duration(policy.FreeCancellationHours) * time.Hour)
+	if now.Before(deadline) || policy.PenaltyNights == 0 {
+		return total, 0
+	}
+
+	if len(nights) == 0 {
+		count := int32(math.Ceil(checkOut.Sub(checkIn).Hours() / 24))
+		if count <= 0 {
+			return total, 0
+		}
+		penalty = total / float32(count) * float32(min(policy.PenaltyNights, count))
+		return total - penalty, penalty
+	}
+
+	var subtotal, charged float32
+	for i, v := range nights {
+		subtotal += v.Price
+		if int32(i) < policy.PenaltyNights {
+			charged += v.Price
+		}
+	}
+	if subtotal == 0 {
+		return total, 0
+	}
+	penalty = float32(math.Round(float64(charged*total/subtotal)*100) / 100)
+	return total - penalty, penalty
+}
@@ -0,0 +1,126 @@
+package cancellation
+
+import (
+	"booking-service/models"
+	"booking-service/pkg/protos/hotel"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	checkIn := time.Date(2026, 11, 10, 14, 0, 0, 0, time.UTC)
+	checkOut := checkIn.AddDate(0, 0, 3)
+	deadline := checkIn.Add(-48 * time.Hour)
+	nights := func(prices ...float32) []*models.NightPrice {
+		res := make([]*models.NightPrice, len(prices))
+		for i, v := range prices {
+			res[i] = &models.NightPrice{Date: checkIn.AddDate(0, 0, i), Price: v}
+		}
+		return res
+	}
+
+	tests := []struct {
+		name            string
+		policy          *hotel.CancellationPolicy
+		nights          []*models.NightPrice
+		total           float32
+		now             time.Time
+		refund, penalty float32
+	}{
+		{
+			name:   "before the deadline is free",
+			policy: &hotel.CancellationPolicy{FreeCancellationHours: 48, PenaltyNights: 1},
+			nights: nights(100, 100, 100),
+			total:  300,
+			now:    deadline.Add(-time.Nanosecond),
+			refund: 300,
+		},
+		{
+			name:    "at the deadline is charged",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 48, PenaltyNights: 1},
+			nights:  nights(100, 100, 100),
+			total:   300,
+			now:     deadline,
+			refund:  200,
+			penalty: 100,
+		},
+		{
+			name:   "no penalty nights is free",
+			policy: &hotel.CancellationPolicy{FreeCancellationHours: 48},
+			nights: nights(100, 100, 100),
+			total:  300,
+			now:    checkIn,
+			refund: 300,
+		},
+		{
+			name:    "first nights are charged at their price",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 2},
+			nights:  nights(100, 120, 150),
+			total:   370,
+			now:     checkIn,
+			refund:  150,
+			penalty: 220,
+		},
+		{
+			name:    "discount is shared by the charged nights",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 2},
+			nights:  nights(100, 120, 150),
+			total:   333,
+			now:     checkIn,
+			refund:  135,
+			penalty: 198,
+		},
+		{
+			name:    "penalty is rounded to cents",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 1},
+			nights:  nights(100, 100, 100),
+			total:   100,
+			now:     checkIn,
+			refund:  66.67,
+			penalty: 33.33,
+		},
+		{
+			name:    "penalty is capped at the whole stay",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 5},
+			nights:  nights(100, 120, 150),
+			total:   370,
+			now:     checkIn,
+			penalty: 370,
+		},
+		{
+			name:   "free nights are not charged",
+			policy: &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 1},
+			nights: nights(0, 0, 0),
+			total:  0,
+			now:    checkIn,
+		},
+		{
+			name:    "without nights the average price is charged",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 1},
+			total:   300,
+			now:     checkIn,
+			refund:  200,
+			penalty: 100,
+		},
+		{
+			name:    "without nights the penalty is capped at the whole stay",
+			policy:  &hotel.CancellationPolicy{FreeCancellationHours: 24, PenaltyNights: 4},
+			total:   300,
+			now:     checkIn,
+			penalty: 300,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refund, penalty := Evaluate(tt.policy, checkIn, checkOut, tt.nights, tt.total, tt.now)
+			if !near(refund, tt.refund) || !near(penalty, tt.penalty) {
+				t.Errorf("Evaluate() = %v, %v, want %v, %v", refund, penalty, tt.refund, tt.penalty)
+			}
+		})
+	}
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 0.001
+}
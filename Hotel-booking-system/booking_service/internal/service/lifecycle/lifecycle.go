@@ -0,0 +1,33 @@
+// Package lifecycle holds the rules for moving a booking between statuses:
+//
+//	pending -> confirmed -> checked_in -> checked_out
+//	pending, confirmed -> cancelled
+//	confirmed -> no_show
+package lifecycle
+
+import (
+	"booking-service/models"
+	"fmt"
+)
+
+var transitions = map[string][]string{
+	models.StatusPending:   {models.StatusConfirmed, models.StatusCancelled},
+	models.StatusConfirmed: {models.StatusCheckedIn, models.StatusCancelled, models.StatusNoShow},
+	models.StatusCheckedIn: {models.StatusCheckedOut},
+}
+
+// Check returns an error wrapping models.ErrStatusTransition when a booking
+// in status from may not be moved to status to.
+func Check(from, to string) error {
+	for _, v := range transitions[from] {
+		if v == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: booking is %s and cannot become %s", models.ErrStatusTransition, from, to)
+}
+
+// Editable reports whether the dates or room of a booking may still be changed.
+func Editable(status string) bool {
+	return status == models.StatusPending || status == models.StatusConfirmed
+}
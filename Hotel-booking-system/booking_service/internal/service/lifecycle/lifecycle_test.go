@@ -0,0 +1,57 @@
+package lifecycle
+
+import (
+	"booking-service/models"
+	"errors"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		from, to string
+		ok       bool
+	}{
+		{models.StatusPending, models.StatusConfirmed, true},
+		{models.StatusPending, models.StatusCancelled, true},
+		{models.StatusPending, models.StatusCheckedIn, false},
+		{models.StatusPending, models.StatusNoShow, false},
+		{models.StatusConfirmed, models.StatusCheckedIn, true},
+		{models.StatusConfirmed, models.StatusCancelled, true},
+		{models.StatusConfirmed, models.StatusNoShow, true},
+		{models.StatusConfirmed, models.StatusCheckedOut, false},
+		{models.StatusConfirmed, models.StatusPending, false},
+		{models.StatusCheckedIn, models.StatusCheckedOut, true},
+		{models.StatusCheckedIn, models.StatusCancelled, false},
+		{models.StatusCheckedIn, models.StatusNoShow, false},
+		{models.StatusCheckedOut, models.StatusCheckedIn, false},
+		{models.StatusCancelled, models.StatusConfirmed, false},
+		{models.StatusNoShow, models.StatusCheckedIn, false},
+		{models.StatusConfirmed, models.StatusConfirmed, false},
+		{"unknown", models.StatusConfirmed, false},
+	}
+	for _, tt := range tests {
+		err := Check(tt.from, tt.to)
+		if tt.ok && err != nil {
+			t.Errorf("Check(%s, %s) = %v, want nil", tt.from, tt.to, err)
+		}
+		if !tt.ok && !errors.Is(err, models.ErrStatusTransition) {
+			t.Errorf("Check(%s, %s) = %v, want ErrStatusTransition", tt.from, tt.to, err)
+		}
+	}
+}
+
+func TestEditable(t *testing.T) {
+	tests := map[string]bool{
+		models.StatusPending:    true,
+		models.StatusConfirmed:  true,
+		models.StatusCheckedIn:  false,
+		models.StatusCheckedOut: false,
+		models.StatusCancelled:  false,
+		models.StatusNoShow:     false,
+	}
+	for status, want := range tests {
+		if got := Editable(status); got != want {
+			t.Errorf("Editable(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -3,10 +3,14 @@ package grpcmethods
 import (
 	"booking-service/internal/brokers/producer"
 	interfaceservices "booking-service/internal/interface/services"
-	"booking-service/pkg/database/methods"
+	"booking-service/models"
 	"booking-service/pkg/protos/booking"
 	"context"
+	"errors"
 	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Grpc struct {
@@ -14,29 +18,39 @@ type Grpc struct {
 	A *interfaceservices.AdjustDatabase
 }
 
+// produce queues a command for the consumer and returns its command id
+func (u *Grpc) produce(key string, req *booking.Bytes) (string, error) {
+	id := producer.NewCommandID()
+	return id, producer.Producer(key, "booking", req.All, producer.Headers(id, req.IdempotencyKey, req.UserId)...)
+}
+
 func (u *Grpc) CancelWaiting(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("deleteW", "booking", req.All); err != nil {
+	id, err := u.produce("deleteW", req)
+	if err != nil {
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "User is deleting will get notification when it's cancelled"}, nil
+	return &booking.GeneralResponse{Message: "User is deleting will get notification when it's cancelled", CommandId: id}, nil
 }
 func (u *Grpc) Create(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("create", "booking", req.All); err != nil {
+	id, err := u.produce("create", req)
+	if err != nil {
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "Creating your request,you will get notification when it's created"}, nil
+	return &booking.GeneralResponse{Message: "Creating your request,you will get notification when it's created", CommandId: id}, nil
 }
 func (u *Grpc) CreateWaiting(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("createW", "booking", req.All); err != nil {
+	id, err := u.produce("createW", req)
+	if err != nil {
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "Creating your request,you will get notification when it's created"}, nil
+	return &booking.GeneralResponse{Message: "Creating your request,you will get notification when it's created", CommandId: id}, nil
 }
 func (u *Grpc) Delete(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("delete", "booking", req.All); err != nil {
+	id, err := u.produce("delete", req)
+	if err != nil {
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "Cancelling your book request,you will get notification when it's cancelled"}, nil
+	return &booking.GeneralResponse{Message: "Cancelling your book request,you will get notification when it's cancelled", CommandId: id}, nil
 }
 func (u *Grpc) Get(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GetUsersBookResponse, error) {
 	res, err := u.A.Get(ctx, req)
@@ -46,6 +60,30 @@ func (u *Grpc) Get(ctx context.Context, req *booking.GetUsersBookRequest) (*book
 	}
 	return res, nil
 }
+func (u *Grpc) CheckIn(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.CheckIn(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *Grpc) CheckOut(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.CheckOut(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *Grpc) MarkNoShow(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.MarkNoShow(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
 func (u *Grpc) GetWaitinglist(ctx context.Context, req *booking.GetWaitinglistRequest) (*booking.GetWaitinglistResponse, error) {
 	res, err := u.A.GetW(ctx, req)
 	if err != nil {
@@ -55,22 +93,159 @@ func (u *Grpc) GetWaitinglist(ctx context.Context, req *booking.GetWaitinglistRe
 	return res, nil
 }
 func (u *Grpc) Update(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("update", "booking", req.All); err != nil {
+	id, err := u.produce("update", req)
+	if err != nil {
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "Updating your request,you will get notification when it's updated"}, nil
+	return &booking.GeneralResponse{Message: "Updating your request,you will get notification when it's updated", CommandId: id}, nil
 }
 func (u *Grpc) UpdateWaiting(ctx context.Context, req *booking.Bytes) (*booking.GeneralResponse, error) {
-	if err := producer.Producer("updateW", "booking", req.All); err != nil {
+	id, err := u.produce("updateW", req)
+	if err != nil {
+		return nil, err
+	}
+	return &booking.GeneralResponse{Message: "Updating your request,you will get notification when it's updated", CommandId: id}, nil
+}
+
+func (u *Grpc) CheckAvailability(ctx context.Context, req *booking.CheckAvailabilityRequest) (*booking.CheckAvailabilityResponse, error) {
+	res, err := u.A.CheckAvailability(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) GetPayment(ctx context.Context, req *booking.GetUsersBookRequest) (*booking.Payment, error) {
+	res, err := u.A.GetPayment(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) GetCommandStatus(ctx context.Context, req *booking.GetCommandStatusRequest) (*booking.CommandStatus, error) {
+	res, err := u.A.GetCommandStatus(ctx, req)
+	if err != nil {
+		log.Println(err)
+		// NotFound lets the gateway ask the other services for the command
+		if errors.Is(err, models.ErrCommandNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) AcceptOffer(ctx context.Context, req *booking.AcceptOfferRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.AcceptOffer(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchAvailability answers InvalidArgument for bad dates or sort orders.
+func (u *Grpc) SearchAvailability(ctx context.Context, req *booking.SearchAvailabilityRequest) (*booking.SearchAvailabilityResponse, error) {
+	res, err := u.A.SearchAvailability(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidStay) || errors.Is(err, models.ErrInvalidSort) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// AssignRoom answers FailedPrecondition when the booking already has a room
+// or the room is not free for its dates.
+func (u *Grpc) AssignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.AssignRoom(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrRoomAssigned) || errors.Is(err, models.ErrRoomBooked) || errors.Is(err, models.ErrRoomNotAvailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReassignRoom answers FailedPrecondition when the booking can't move or the
+// room is not a free room of its hotel.
+func (u *Grpc) ReassignRoom(ctx context.Context, req *booking.AssignRoomRequest) (*booking.GeneralResponse, error) {
+	res, err := u.A.ReassignRoom(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrStatusTransition) || errors.Is(err, models.ErrRoomBooked) || errors.Is(err, models.ErrRoomNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) GetOccupancyCalendar(ctx context.Context, req *booking.GetOccupancyCalendarRequest) (*booking.OccupancyCalendar, error) {
+	res, err := u.A.GetOccupancyCalendar(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrHotelNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) GetReport(ctx context.Context, req *booking.ReportRequest) (*booking.Report, error) {
+	res, err := u.A.GetReport(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidReport) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrHotelNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Grpc) Getall(ctx context.Context, req *booking.Request) (*booking.Response, error) {
+	res, err := u.A.Getall(ctx, req)
+	if err != nil {
+		log.Println(err)
 		return nil, err
 	}
-	return &booking.GeneralResponse{Message: "Updating your request,you will get notification when it's updated"}, nil
+	return res, nil
+}
+
+func (u *Grpc) ListBookings(ctx context.Context, req *booking.ListRequest) (*booking.ListBookingsResponse, error) {
+	res, err := u.A.ListBookings(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidList) || errors.Is(err, models.ErrInvalidSort) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
 }
 
-func (u *Grpc) Getall(context.Context, *booking.Request) (*booking.Response, error) {
-	res, err := methods.GetAllWAitingUSers()
+func (u *Grpc) ListWaitingList(ctx context.Context, req *booking.ListRequest) (*booking.ListWaitingListResponse, error) {
+	res, err := u.A.ListWaitingList(ctx, req)
 	if err != nil {
 		log.Println(err)
+		if errors.Is(err, models.ErrInvalidList) || errors.Is(err, models.ErrInvalidSort) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	return res, nil
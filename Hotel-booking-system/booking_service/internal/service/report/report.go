@@ -0,0 +1,156 @@
+// Package report turns the nights and arrivals of a hotel into the usual
+// revenue figures, a row per period:
+//
+//	occupancy rate = room nights sold / room nights available
+//	ADR            = revenue / room nights sold
+//	RevPAR         = revenue / room nights available
+//	cancellation   = cancelled arrivals / arrivals
+//
+// Room nights available come from the rooms the hotel has today, the
+// service keeps no history of its inventory.
+package report
+
+import (
+	"booking-service/models"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MaxDays is the longest range a report may cover.
+const MaxDays = 731
+
+// Period is one column of a report, [Start, End).
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Periods splits [from, to) into days, weeks starting on Monday or calendar
+// months. The first and last periods are cut to the range.
+func Periods(from, to time.Time, period string) ([]Period, error) {
+	from, to = day(from), day(to)
+	if !to.After(from) || to.Sub(from) > MaxDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: the range must end after it starts and span at most %d days", models.ErrInvalidReport, MaxDays)
+	}
+	var next func(time.Time) time.Time
+	switch period {
+	case models.PeriodDay:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case models.PeriodWeek:
+		next = func(t time.Time) time.Time {
+			// Weekday is 0 on Sunday, weeks start on Monday
+			return t.AddDate(0, 0, 7-(int(t.Weekday())+6)%7)
+		}
+	case models.PeriodMonth:
+		next = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC) }
+	default:
+		return nil, fmt.Errorf("%w: period must be %q, %q or %q", models.ErrInvalidReport, models.PeriodDay, models.PeriodWeek, models.PeriodMonth)
+	}
+
+	var res []Period
+	for start := from; start.Before(to); {
+		end := next(start)
+		if end.After(to) {
+			end = to
+		}
+		res = append(res, Period{Start: start, End: end})
+		start = end
+	}
+	return res, nil
+}
+
+// Build adds up sales and arrivals per period and room type. rooms is the
+// number of rooms of each type. Without byRoomType all types are summed
+// into rows with an empty RoomType.
+func Build(periods []Period, rooms map[string]int32, sales []*models.NightSales, arrivals []*models.Arrivals, byRoomType bool) []*models.ReportRow {
+	key := func(roomType string) string {
+		if byRoomType {
+			return roomType
+		}
+		return ""
+	}
+	inventory := make(map[string]int32)
+	for roomType, n := range rooms {
+		inventory[key(roomType)] += n
+	}
+	types := make(map[string]bool, len(inventory))
+	for roomType := range inventory {
+		types[roomType] = true
+	}
+	for _, v := range sales {
+		types[key(v.RoomType)] = true
+	}
+	for _, v := range arrivals {
+		types[key(v.RoomType)] = true
+	}
+	names := make([]string, 0, len(types))
+	for roomType := range types {
+		names = append(names, roomType)
+	}
+	sort.Strings(names)
+
+	var res []*models.ReportRow
+	index := make(map[Period]map[string]*models.ReportRow, len(periods))
+	for _, p := range periods {
+		days := int32(p.End.Sub(p.Start).Hours() / 24)
+		index[p] = make(map[string]*models.ReportRow, len(names))
+		for _, roomType := range names {
+			row := &models.ReportRow{
+				PeriodStart:         p.Start,
+				PeriodEnd:           p.End,
+				RoomType:            roomType,
+				Rooms:               inventory[roomType],
+				RoomNightsAvailable: inventory[roomType] * days,
+			}
+			index[p][roomType] = row
+			res = append(res, row)
+		}
+	}
+	find := func(t time.Time, roomType string) *models.ReportRow {
+		t = day(t)
+		i := sort.Search(len(periods), func(i int) bool { return periods[i].End.After(t) })
+		if i == len(periods) || t.Before(periods[i].Start) {
+			return nil
+		}
+		return index[periods[i]][key(roomType)]
+	}
+
+	type lead struct{ days, bookings int32 }
+	leads := make(map[*models.ReportRow]lead)
+	for _, v := range sales {
+		if row := find(v.Night, v.RoomType); row != nil {
+			row.RoomNightsSold += v.Sold
+			row.Revenue += v.Revenue
+		}
+	}
+	for _, v := range arrivals {
+		if row := find(v.Date, v.RoomType); row != nil {
+			row.Arrivals += v.Bookings
+			row.Cancellations += v.Cancelled
+			l := leads[row]
+			leads[row] = lead{l.days + v.LeadDays, l.bookings + v.Leads}
+		}
+	}
+	for _, row := range res {
+		row.OccupancyRate = ratio(float32(row.RoomNightsSold), row.RoomNightsAvailable)
+		row.ADR = ratio(row.Revenue, row.RoomNightsSold)
+		row.RevPAR = ratio(row.Revenue, row.RoomNightsAvailable)
+		row.CancellationRate = ratio(float32(row.Cancellations), row.Arrivals)
+		l := leads[row]
+		row.AvgLeadTimeDays = ratio(float32(l.days), l.bookings)
+	}
+	return res
+}
+
+// ratio is a / b, or 0 when there is nothing to divide by.
+func ratio(a float32, b int32) float32 {
+	if b == 0 {
+		return 0
+	}
+	return a / float32(b)
+}
+
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
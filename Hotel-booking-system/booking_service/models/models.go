@@ -14,6 +14,20 @@ type BookHotelRequest struct {
 	RoomType     string    `json:"roomType"`
 	CheckInDate  time.Time `json:"checkInDate"`
 	CheckOutDate time.Time `json:"checkOutDate"`
+	PaymentToken string    `json:"payment_token"`
+	// AssignLater reserves RoomType without a room; RoomID stays zero until
+	// AssignRoom or check-in picks one.
+	AssignLater bool `json:"assign_later"`
+	// Inventory lists the rooms of RoomType. Create refuses the booking when
+	// all of them are taken on some night of the stay, or when it is empty.
+	Inventory []int32 `json:"inventory"`
+	// PreferredFloor and RoomAmenities are what the guest asks of the room
+	// picked for an AssignLater booking.
+	PreferredFloor *int32   `json:"preferred_floor"`
+	RoomAmenities  []string `json:"room_amenities"`
+	// SagaID is the booking saga making the booking, zero outside a saga.
+	// Create returns the booking the saga already made instead of a new one.
+	SagaID int32 `json:"saga_id"`
 }
 
 type GetUsersBookRequest struct {
@@ -25,15 +39,26 @@ type GetRoomInfo struct {
 	RoomID  int32 `json:"room_id"`
 }
 type GetUsersBookResponse struct {
-	ID           int32     `json:"id"`
-	UserID       int32     `json:"userID"`
-	HotelID      int32     `json:"hotelID"`
+	ID      int32 `json:"id"`
+	UserID  int32 `json:"userID"`
+	HotelID int32 `json:"hotelID"`
+	// RoomID is zero while the booking waits for a room.
 	RoomID       int32     `json:"room_id"`
 	RoomType     string    `json:"roomType"`
 	CheckInDate  time.Time `json:"checkInDate"`
 	CheckOutDate time.Time `json:"checkOutDate"`
 	TotalAmount  float32   `json:"totalAmount"`
 	Status       string    `json:"status"`
+	// CancelledAt is zero unless the booking was cancelled.
+	CancelledAt   time.Time `json:"cancelledAt"`
+	CancelReason  string    `json:"cancelReason"`
+	RefundAmount  float32   `json:"refundAmount"`
+	PenaltyAmount float32   `json:"penaltyAmount"`
+	Discount      float32   `json:"discount"`
+	// Nights is loaded separately, see Database.Nights.
+	Nights         []*NightPrice `json:"nights"`
+	PreferredFloor *int32        `json:"preferred_floor"`
+	RoomAmenities  []string      `json:"room_amenities"`
 }
 
 type BookHotelUpdateRequest struct {
@@ -42,6 +67,10 @@ type BookHotelUpdateRequest struct {
 	RoomType     string                 `json:"roomType"`
 	CheckInDate  *timestamppb.Timestamp `json:"checkInDate"`
 	CheckOutDate *timestamppb.Timestamp `json:"checkOutDate"`
+	PaymentToken string                 `json:"payment_token"`
+	// Inventory lists the rooms of the room type the booking ends up with,
+	// Update refuses the change when all of them are taken.
+	Inventory []int32 `json:"inventory"`
 }
 
 type GeneralResponse struct {
@@ -49,7 +78,12 @@ type GeneralResponse struct {
 }
 
 type CancelRoomRequest struct {
-	ID int32 `json:"id"`
+	ID     int32  `json:"id"`
+	Reason string `json:"reason"`
+	// From is the status the booking must still be in when it is cancelled.
+	From          string  `json:"from"`
+	RefundAmount  float32 `json:"refundAmount"`
+	PenaltyAmount float32 `json:"penaltyAmount"`
 }
 
 type CreateWaitingList struct {
@@ -74,6 +108,10 @@ type GetWaitinglistResponse struct {
 	CheckOutDate time.Time `json:"checkOutDate"`
 	Status       string    `json:"status"`
 	ID           int32     `json:"id"`
+	// BookingID is the booking that holds a room for an offered entry.
+	BookingID int32 `json:"booking_id"`
+	// OfferExpiresAt is zero unless the entry has been offered a room.
+	OfferExpiresAt time.Time `json:"offer_expires_at"`
 }
 
 type UpdateWaitingListRequest struct {
@@ -89,8 +127,361 @@ type DeleteWaitingList struct {
 	ID int32 `json:"id"`
 }
 
+const (
+	WaitingStatusWaiting  = "waiting"
+	WaitingStatusOffered  = "offered"
+	WaitingStatusAccepted = "accepted"
+	WaitingStatusExpired  = "expired"
+)
+
+// WaitingMatchRequest selects the waiting entries for a room type whose stay
+// overlaps [CheckInDate, CheckOutDate).
+type WaitingMatchRequest struct {
+	HotelID      int32     `json:"hotel_id"`
+	RoomType     string    `json:"room_type"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+}
+
+// OfferRequest offers the room held by BookingID to a waiting entry until ExpiresAt.
+type OfferRequest struct {
+	ID        int32     `json:"id"`
+	BookingID int32     `json:"booking_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type WaitingStatusRequest struct {
+	ID   int32  `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+const (
+	StatusPending    = "pending"
+	StatusConfirmed  = "confirmed"
+	StatusCheckedIn  = "checked_in"
+	StatusCheckedOut = "checked_out"
+	StatusCancelled  = "cancelled"
+	StatusNoShow     = "no_show"
+)
+
+// ReleasedStatuses are the booking statuses that no longer hold a room.
+var ReleasedStatuses = []string{StatusCancelled, StatusNoShow}
+
+// SoldStatuses are the booking statuses reports count as sold room nights.
+var SoldStatuses = []string{StatusConfirmed, StatusCheckedIn, StatusCheckedOut}
+
+type UpdateStatusRequest struct {
+	ID   int32  `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+	// CheckOutDate moves the end of the stay, e.g. on an early check-out. Zero keeps it.
+	CheckOutDate time.Time `json:"checkOutDate"`
+}
+
+type CheckAvailabilityRequest struct {
+	HotelID      int32     `json:"hotel_id"`
+	RoomIDs      []int32   `json:"room_ids"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+	ExcludeID    int32     `json:"exclude_id"`
+}
+
+// LoadRequest counts, night by night, the bookings overlapping
+// [CheckInDate, CheckOutDate) that hold a room of a hotel or wait for one.
+type LoadRequest struct {
+	HotelIDs     []int32   `json:"hotel_ids"`
+	RoomType     string    `json:"room_type"`
+	RoomIDs      []int32   `json:"room_ids"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+	ExcludeID    int32     `json:"exclude_id"`
+}
+
+// TypeLoad is the most bookings without a room of one room type on a single night.
+type TypeLoad struct {
+	HotelID  int32  `json:"hotel_id"`
+	RoomType string `json:"room_type"`
+	Load     int32  `json:"load"`
+}
+
+// AssignRoomRequest gives a room to a booking that has none yet.
+// RoomType is the type of RoomID and Inventory all the rooms of that type.
+type AssignRoomRequest struct {
+	ID        int32   `json:"id"`
+	RoomID    int32   `json:"room_id"`
+	RoomType  string  `json:"room_type"`
+	Inventory []int32 `json:"inventory"`
+}
+
+const (
+	SortCheckInAsc  = "check_in_asc"
+	SortCheckInDesc = "check_in_desc"
+	SortNewest      = "newest"
+	SortOldest      = "oldest"
+)
+
+// ListRequest filters bookings or waiting list entries; zero values mean no
+// filter. From and To keep the stays overlapping [From, To). After is the
+// last entry of the previous page, nil for the first one.
+type ListRequest struct {
+	UserID  int32     `json:"user_id"`
+	HotelID int32     `json:"hotel_id"`
+	Status  string    `json:"status"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Sort    string    `json:"sort"`
+	After   *Cursor   `json:"after"`
+	Limit   int32     `json:"limit"`
+}
+
+// Cursor is where a page ends: the check-in date and ID of its last entry.
+type Cursor struct {
+	CheckInDate time.Time `json:"checkInDate"`
+	ID          int32     `json:"id"`
+}
+
+// CalendarRequest selects the live bookings of a hotel that hold a room or
+// wait for one on some night of [From, To).
+type CalendarRequest struct {
+	HotelID int32     `json:"hotel_id"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+}
+
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// ReportRequest asks for the figures of a hotel over [From, To), a row per
+// Period and, with ByRoomType, per room type.
+type ReportRequest struct {
+	HotelID    int32     `json:"hotel_id"`
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
+	Period     string    `json:"period"`
+	ByRoomType bool      `json:"by_room_type"`
+}
+
+// NightSales is what one room type sold on one night: Sold rooms for
+// Revenue, the prices of those nights after discounts.
+type NightSales struct {
+	RoomType string    `json:"room_type"`
+	Night    time.Time `json:"night"`
+	Sold     int32     `json:"sold"`
+	Revenue  float32   `json:"revenue"`
+}
+
+// Arrivals counts the bookings of one room type arriving on Date. LeadDays
+// is the sum of days between booking and arrival over Leads bookings that
+// were not cancelled and know when they were made.
+type Arrivals struct {
+	RoomType  string    `json:"room_type"`
+	Date      time.Time `json:"date"`
+	Bookings  int32     `json:"bookings"`
+	Cancelled int32     `json:"cancelled"`
+	LeadDays  int32     `json:"lead_days"`
+	Leads     int32     `json:"leads"`
+}
+
+// ReportRow holds the figures of one period, for one room type or for the
+// whole hotel when RoomType is empty.
+type ReportRow struct {
+	PeriodStart         time.Time `json:"period_start"`
+	PeriodEnd           time.Time `json:"period_end"`
+	RoomType            string    `json:"room_type"`
+	Rooms               int32     `json:"rooms"`
+	RoomNightsAvailable int32     `json:"room_nights_available"`
+	RoomNightsSold      int32     `json:"room_nights_sold"`
+	OccupancyRate       float32   `json:"occupancy_rate"`
+	Revenue             float32   `json:"revenue"`
+	ADR                 float32   `json:"adr"`
+	RevPAR              float32   `json:"revpar"`
+	Arrivals            int32     `json:"arrivals"`
+	Cancellations       int32     `json:"cancellations"`
+	CancellationRate    float32   `json:"cancellation_rate"`
+	AvgLeadTimeDays     float32   `json:"avg_lead_time_days"`
+}
+
+// UnassignedRequest selects the live bookings without a room that arrive
+// before Before, longest stays first within a day.
+type UnassignedRequest struct {
+	Before time.Time `json:"before"`
+	Limit  int32     `json:"limit"`
+}
+
+// RoomNeighbours selects, for each of RoomIDs, the closest bookings ending
+// on or before CheckInDate and starting on or after CheckOutDate.
+type RoomNeighbours struct {
+	HotelID      int32     `json:"hotel_id"`
+	RoomIDs      []int32   `json:"room_ids"`
+	CheckInDate  time.Time `json:"checkInDate"`
+	CheckOutDate time.Time `json:"checkOutDate"`
+	ExcludeID    int32     `json:"exclude_id"`
+}
+
+// Neighbours is the end of the previous and the start of the next booking
+// of a room. A zero time means the room is free that way.
+type Neighbours struct {
+	RoomID   int32     `json:"room_id"`
+	PrevEnd  time.Time `json:"prev_end"`
+	NextFrom time.Time `json:"next_from"`
+}
+
+type NightPrice struct {
+	Date  time.Time `json:"date"`
+	Price float32   `json:"price"`
+}
+
+// Quote is the night-by-night price of a stay as the hotel service quoted it.
+type Quote struct {
+	Nights   []*NightPrice `json:"nights"`
+	Subtotal float32       `json:"subtotal"`
+	Discount float32       `json:"discount"`
+	Total    float32       `json:"total"`
+}
+
+const (
+	PaymentAuthorized = "authorized"
+	PaymentDeclined   = "declined"
+	PaymentCaptured   = "captured"
+	PaymentVoided     = "voided"
+	PaymentRefunded   = "refunded"
+)
+
+type Payment struct {
+	ID             int32           `json:"id"`
+	BookingID      int32           `json:"booking_id"`
+	Provider       string          `json:"provider"`
+	ProviderRef    string          `json:"provider_ref"`
+	Status         string          `json:"status"`
+	Amount         float32         `json:"amount"`
+	CapturedAmount float32         `json:"captured_amount"`
+	RefundedAmount float32         `json:"refunded_amount"`
+	Events         []*PaymentEvent `json:"events"`
+}
+
+// PaymentEvent records one status change of a payment.
+type PaymentEvent struct {
+	PaymentID  int32     `json:"payment_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Amount     float32   `json:"amount"`
+	Error      string    `json:"error"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// UpdatePaymentRequest moves a payment from status From to To and records
+// the event. Amount is the money moved by this step.
+type UpdatePaymentRequest struct {
+	ID             int32   `json:"id"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Amount         float32 `json:"amount"`
+	CapturedAmount float32 `json:"captured_amount"`
+	RefundedAmount float32 `json:"refunded_amount"`
+	Error          string  `json:"error"`
+}
+
+const (
+	SagaRunning      = "running"
+	SagaCompleted    = "completed"
+	SagaCompensating = "compensating"
+	SagaCompensated  = "compensated"
+	SagaFailed       = "failed"
+)
+
+// Saga is the persisted state of a multi-step operation. While it runs, Step
+// is the index of the next step; while it compensates, it is the number of
+// completed steps that are not undone yet. State is the JSON the steps share.
+type Saga struct {
+	ID     int32  `json:"id"`
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+	Step   int32  `json:"step"`
+	State  []byte `json:"state"`
+	Error  string `json:"error"`
+}
+
+const (
+	SagaActionDo         = "do"
+	SagaActionCompensate = "compensate"
+)
+
+// SagaStep records one execution or compensation of a saga step.
+type SagaStep struct {
+	SagaID int32  `json:"saga_id"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	Error  string `json:"error"`
+}
+
+const (
+	CommandSucceeded = "succeeded"
+	CommandFailed    = "failed"
+)
+
+// Command is the result of an asynchronous write taken from Kafka.
+// EntityID is the booking or waiting list entry the command created or changed.
+type Command struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	UserID    int32     `json:"user_id"`
+	Status    string    `json:"status"`
+	EntityID  int32     `json:"entity_id"`
+	Message   string    `json:"message"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RoomHold is a room the old booking flow left unavailable in the hotel
+// service.
+type RoomHold struct {
+	HotelID int32 `json:"hotel_id"`
+	RoomID  int32 `json:"room_id"`
+}
+
+type GetCommandRequest struct {
+	ID string `json:"id"`
+}
+
+// ProcessedKey is the result of the first successful command sent with an
+// idempotency key; repeats of the command get this result back.
+type ProcessedKey struct {
+	Key       string `json:"key"`
+	Kind      string `json:"kind"`
+	CommandID string `json:"command_id"`
+	EntityID  int32  `json:"entity_id"`
+	Message   string `json:"message"`
+}
+
 var (
 	ErrHotelNotFound    = errors.New("there is no such hotel with this id")
 	ErrRoomNotFound     = errors.New("no room found matching the given criteria")
 	ErrRoomNotAvailable = errors.New("room is not available for the requested dates")
+	ErrInvalidStay      = errors.New("check-out date must be after check-in date")
+	ErrRoomBooked       = errors.New("room is already booked for the requested dates")
+	ErrNoInventory      = errors.New("the rooms of the room type are not known")
+	ErrStatusTransition = errors.New("booking status transition is not allowed")
+	ErrStatusChanged    = errors.New("booking status was changed by another request")
+	ErrTooEarly         = errors.New("the check-in date has not come yet")
+	ErrPaymentDeclined  = errors.New("payment was declined")
+	ErrPaymentRequired  = errors.New("a payment token is required to pay the new price")
+	ErrNoPaymentToken   = errors.New("payment token is missing")
+	ErrPaymentNotFound  = errors.New("booking has no payment")
+	ErrPaymentChanged   = errors.New("payment status was changed by another request")
+	ErrCommandNotFound  = errors.New("command not found or not processed yet")
+	ErrKeyNotProcessed  = errors.New("idempotency key was not processed yet")
+	ErrOfferNotOpen     = errors.New("waiting list entry has no open offer")
+	ErrWaitingChanged   = errors.New("waiting list entry was changed by another request")
+	ErrInvalidSort      = errors.New("unknown sort order")
+	ErrRoomTypeRequired = errors.New("a room type is required to book without a room")
+	ErrRoomAssigned     = errors.New("booking already has a room or can no longer get one")
+	ErrNoRoomMatch      = errors.New("no free room matches the guest preferences")
+	ErrInvalidRange     = errors.New("the calendar must end after it starts and span at most 92 days")
+	ErrInvalidReport    = errors.New("invalid report request")
+	ErrInvalidList      = errors.New("invalid list request")
 )
@@ -4,33 +4,19 @@ import (
 	"booking-service/models"
 	"fmt"
 	"log"
-	"math"
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func Create(req *models.BookHotelRequest, roomPrice float64) (string, []interface{}, error) {
-	// if req.CheckInDate.Before(time.Now().Truncate(24 * time.Hour)) {
-	// 	return "", nil, errors.New("check-in date cannot be in the past")
-	// }
-
-	totalCost := TotalCostCalculate(req.CheckInDate, req.CheckOutDate, roomPrice)
-	fmt.Println(roomPrice)
-	fmt.Println(totalCost)
-	fmt.Println(req.CheckInDate)
-	fmt.Println(req.CheckOutDate)
-	var status string
-	today := time.Now().Truncate(24 * time.Hour)
-	if req.CheckInDate.Equal(today) {
-		status = "Guest Living"
-	} else {
-		status = "Booked"
-	}
+func Create(req *models.BookHotelRequest, quote *models.Quote) (string, []interface{}, error) {
 	query, args, err := squirrel.Insert("booked").
-		Columns("user_id", "hotel_id", "room_id", "room_type", "enterydate", "leavingdate", "totalcost", "status").
-		Values(req.UserID, req.HotelID, req.RoomID, req.RoomType, req.CheckInDate, req.CheckOutDate, totalCost, status).
+		Columns("user_id", "hotel_id", "room_id", "room_type", "enterydate", "leavingdate", "totalcost", "discount", "status",
+			"preferred_floor", "room_amenities", "saga_id").
+		Values(req.UserID, req.HotelID, roomID(req.RoomID), req.RoomType, req.CheckInDate, req.CheckOutDate, quote.Total, quote.Discount, models.StatusPending,
+			req.PreferredFloor, pq.Array(roomAmenities(req.RoomAmenities)), sagaID(req.SagaID)).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
 		ToSql()
@@ -42,8 +28,54 @@ func Create(req *models.BookHotelRequest, roomPrice float64) (string, []interfac
 	return query, args, nil
 }
 
+// roomID stores the room of a booking that has none yet as NULL.
+func roomID(id int32) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// sagaID stores a booking made outside a saga with a NULL saga_id.
+func sagaID(id int32) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// BySaga selects the booking made by saga id.
+func BySaga(id int32) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id").
+		From("booked").
+		Where(squirrel.Eq{"saga_id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// roomAmenities stores no amenities as an empty array, the column is NOT NULL.
+func roomAmenities(codes []string) []string {
+	if codes == nil {
+		return []string{}
+	}
+	return codes
+}
+
+// bookingColumns lists the booked columns in the order Database scans them.
+// A booking without a room reads as room 0.
+var bookingColumns = []string{
+	"id", "user_id", "hotel_id", "COALESCE(room_id, 0)", "room_type", "enterydate", "leavingdate", "totalcost", "status",
+	"cancelled_at", "cancel_reason", "refund_amount", "penalty_amount", "discount",
+	"preferred_floor", "room_amenities",
+}
+
 func Get(req *models.GetUsersBookRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
+	query, args, err := squirrel.Select(bookingColumns...).
 		From("booked").
 		Where(squirrel.Eq{"id": req.ID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -62,7 +94,7 @@ func timestampToTime(ts *timestamppb.Timestamp) time.Time {
 	return ts.AsTime()
 }
 
-func Update(req *models.BookHotelUpdateRequest, roomPrice float64) (string, []interface{}, error) {
+func Update(req *models.BookHotelUpdateRequest, quote *models.Quote) (string, []interface{}, error) {
 	setMap := make(map[string]interface{})
 
 	// Convert timestamppb.Timestamp to time.Time
@@ -81,11 +113,10 @@ func Update(req *models.BookHotelUpdateRequest, roomPrice float64) (string, []in
 	if req.RoomType != "" {
 		setMap["room_type"] = req.RoomType
 	}
-	if checkInDate != (time.Time{}) && checkOutDate != (time.Time{}) {
-		totalCost := TotalCostCalculate(checkInDate, checkOutDate, roomPrice)
-		setMap["totalcost"] = totalCost
+	if quote != nil {
+		setMap["totalcost"] = quote.Total
+		setMap["discount"] = quote.Discount
 	}
-	setMap["status"] = "updated"
 
 	// Build SQL query using squirrel
 	query, args, err := squirrel.Update("booked").
@@ -101,17 +132,20 @@ func Update(req *models.BookHotelUpdateRequest, roomPrice float64) (string, []in
 	return query, args, nil
 }
 
-// TotalCostCalculate is a placeholder function; replace it with your actual implementation
-//
-//	func TotalCostCalculate(checkInDate, checkOutDate time.Time, roomPrice float64) float64 {
-//		// Example implementation for calculating total cost
-//		duration := checkOutDate.Sub(checkInDate).Hours() / 24
-//		return duration * roomPrice
-//	}
+// Cancel keeps the booking row for history and records when and why it was
+// cancelled together with the refund and penalty computed from the policy.
 func Cancel(req *models.CancelRoomRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Delete("booked").
-		Where(squirrel.Eq{"id": req.ID}).
+	query, args, err := squirrel.Update("booked").
+		SetMap(map[string]interface{}{
+			"status":         models.StatusCancelled,
+			"cancelled_at":   squirrel.Expr("NOW()"),
+			"cancel_reason":  req.Reason,
+			"refund_amount":  req.RefundAmount,
+			"penalty_amount": req.PenaltyAmount,
+		}).
+		Where(squirrel.Eq{"id": req.ID, "status": req.From}).
 		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
 		log.Println(err)
@@ -119,31 +153,30 @@ func Cancel(req *models.CancelRoomRequest) (string, []interface{}, error) {
 	}
 	return query, args, nil
 }
-func TotalCostCalculate(in, out time.Time, price float64) float64 {
-	// Ensure 'out' is after 'in'
-	if !out.After(in) {
-		fmt.Println("Check-out date must be after check-in date.")
-		return 0
-	}
-
-	// Calculate the total hours between the two times
-	hours := out.Sub(in).Hours()
-
-	// Convert hours to days, rounding up to count partial days as full days
-	days := math.Ceil(hours / 24)
-
-	// Calculate total cost
-	totalCost := days * price
-
-	fmt.Printf("Check-in: %v, Check-out: %v, Days: %v, Total cost: %v\n", in, out, days, totalCost)
 
-	return totalCost
+// UpdateStatus moves a booking to req.To only while it is still in req.From,
+// so two concurrent transitions cannot both succeed.
+func UpdateStatus(req *models.UpdateStatusRequest) (string, []interface{}, error) {
+	setMap := map[string]interface{}{"status": req.To}
+	if !req.CheckOutDate.IsZero() {
+		setMap["leavingdate"] = req.CheckOutDate
+	}
+	query, args, err := squirrel.Update("booked").
+		SetMap(setMap).
+		Where(squirrel.Eq{"id": req.ID, "status": req.From}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
 }
-
 func CreateWaitingList(req *models.CreateWaitingList) (string, []interface{}, error) {
 	query, args, err := squirrel.Insert("waitinglist").
 		Columns("user_id", "hotel_id", "room_type", "user_email", "enterydate", "leavingdate", "status").
-		Values(req.UserID, req.HotelID, req.RoomType, req.UserEmail, req.CheckInDate, req.CheckOutDate, "waiting").
+		Values(req.UserID, req.HotelID, req.RoomType, req.UserEmail, req.CheckInDate, req.CheckOutDate, models.WaitingStatusWaiting).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
 		ToSql()
@@ -154,8 +187,14 @@ func CreateWaitingList(req *models.CreateWaitingList) (string, []interface{}, er
 	return query, args, nil
 }
 
+// waitingColumns lists the waitinglist columns in the order Database scans them.
+var waitingColumns = []string{
+	"id", "user_id", "hotel_id", "room_type", "user_email", "enterydate", "leavingdate", "status",
+	"booking_id", "offer_expires_at",
+}
+
 func GetWaitingList(req *models.GetWaitinglistRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
+	query, args, err := squirrel.Select(waitingColumns...).
 		From("waitinglist").
 		Where(squirrel.Eq{"id": req.ID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -176,7 +215,7 @@ func UpdateWaitingList(req *models.UpdateWaitingListRequest) (string, []interfac
 		setMap["leavingdate"] = req.CheckOutDate
 	}
 	if req.HotelID != 0 {
-		setMap["room_id"] = req.HotelID
+		setMap["hotel_id"] = req.HotelID
 	}
 	if req.RoomType != "" {
 		setMap["room_type"] = req.RoomType
@@ -184,10 +223,10 @@ func UpdateWaitingList(req *models.UpdateWaitingListRequest) (string, []interfac
 	if req.UserID != 0 {
 		setMap["user_id"] = req.UserID
 	}
-	setMap["status"] = "updated"
+	// Entries that have been offered a room keep their stay
 	query, args, err := squirrel.Update("waitinglist").
 		SetMap(setMap).
-		Where(squirrel.Eq{"id": req.ID}).
+		Where(squirrel.Eq{"id": req.ID, "status": models.WaitingStatusWaiting}).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
 		ToSql()
@@ -210,8 +249,74 @@ func DeleteWaitingList(req *models.DeleteWaitingList) (string, []interface{}, er
 	return query, args, nil
 }
 
+// MatchWaitingList selects the waiting entries of a room type whose stay
+// overlaps the given one and has not started yet, first come first served.
+func MatchWaitingList(req *models.WaitingMatchRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select(waitingColumns...).
+		From("waitinglist").
+		Where(squirrel.Eq{"hotel_id": req.HotelID, "room_type": req.RoomType, "status": models.WaitingStatusWaiting}).
+		Where(squirrel.Lt{"enterydate": req.CheckOutDate}).
+		Where(squirrel.Gt{"leavingdate": req.CheckInDate}).
+		Where("enterydate >= CURRENT_DATE").
+		OrderBy("created_at", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func OfferWaitingList(req *models.OfferRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("waitinglist").
+		Set("status", models.WaitingStatusOffered).
+		Set("booking_id", req.BookingID).
+		Set("offer_expires_at", req.ExpiresAt).
+		Where(squirrel.Eq{"id": req.ID, "status": models.WaitingStatusWaiting}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// UpdateWaitingStatus moves an entry to req.To only if it is still in req.From.
+func UpdateWaitingStatus(req *models.WaitingStatusRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("waitinglist").
+		Set("status", req.To).
+		Where(squirrel.Eq{"id": req.ID, "status": req.From}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// ExpiredOffers selects the offered entries whose hold has run out.
+func ExpiredOffers() (string, []interface{}, error) {
+	query, args, err := squirrel.Select(waitingColumns...).
+		From("waitinglist").
+		Where(squirrel.Eq{"status": models.WaitingStatusOffered}).
+		Where("offer_expires_at < NOW()").
+		OrderBy("offer_expires_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
 func GetRoomInfo(req *models.GetRoomInfo) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
+	query, args, err := squirrel.Select(bookingColumns...).
 		From("booked").
 		Where(squirrel.Eq{"hotel_id": req.HotelID, "room_id": req.RoomID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -223,9 +328,565 @@ func GetRoomInfo(req *models.GetRoomInfo) (string, []interface{}, error) {
 	return query, args, nil
 }
 
-func GetAllUsers() (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
-		From("waitinglist").
+// ListBookings selects a page of bookings, see list.
+func ListBookings(req *models.ListRequest) (string, []interface{}, error) {
+	return list("booked", bookingColumns, req)
+}
+
+// ListWaitingList selects a page of waiting list entries, see list.
+func ListWaitingList(req *models.ListRequest) (string, []interface{}, error) {
+	return list("waitinglist", waitingColumns, req)
+}
+
+// list selects from booked or waitinglist, which share the filtered
+// columns, the page after req.After in req.Sort order. It asks for one row
+// more than req.Limit to tell whether another page follows.
+func list(table string, columns []string, req *models.ListRequest) (string, []interface{}, error) {
+	builder := squirrel.Select(columns...).From(table)
+	if req.UserID != 0 {
+		builder = builder.Where(squirrel.Eq{"user_id": req.UserID})
+	}
+	if req.HotelID != 0 {
+		builder = builder.Where(squirrel.Eq{"hotel_id": req.HotelID})
+	}
+	if req.Status != "" {
+		builder = builder.Where(squirrel.Eq{"status": req.Status})
+	}
+	if !req.From.IsZero() {
+		builder = builder.Where(squirrel.Gt{"leavingdate": req.From})
+	}
+	if !req.To.IsZero() {
+		builder = builder.Where(squirrel.Lt{"enterydate": req.To})
+	}
+
+	switch req.Sort {
+	case models.SortCheckInDesc:
+		if req.After != nil {
+			builder = builder.Where("(enterydate, id) < (?, ?)", req.After.CheckInDate, req.After.ID)
+		}
+		builder = builder.OrderBy("enterydate DESC", "id DESC")
+	case models.SortNewest:
+		if req.After != nil {
+			builder = builder.Where(squirrel.Lt{"id": req.After.ID})
+		}
+		builder = builder.OrderBy("id DESC")
+	case models.SortOldest:
+		if req.After != nil {
+			builder = builder.Where(squirrel.Gt{"id": req.After.ID})
+		}
+		builder = builder.OrderBy("id")
+	default:
+		if req.After != nil {
+			builder = builder.Where("(enterydate, id) > (?, ?)", req.After.CheckInDate, req.After.ID)
+		}
+		builder = builder.OrderBy("enterydate", "id")
+	}
+
+	query, args, err := builder.Limit(uint64(req.Limit) + 1).PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// BookedRooms selects the rooms that have a booking overlapping the half-open
+// stay [CheckInDate, CheckOutDate), so a guest may check in on the day another leaves.
+// A zero HotelID looks at the given rooms of any hotel.
+func BookedRooms(req *models.CheckAvailabilityRequest) (string, []interface{}, error) {
+	builder := squirrel.Select("DISTINCT room_id").
+		From("booked").
+		Where("room_id IS NOT NULL").
+		Where(squirrel.Lt{"enterydate": req.CheckOutDate}).
+		Where(squirrel.Gt{"leavingdate": req.CheckInDate}).
+		Where(squirrel.NotEq{"status": models.ReleasedStatuses})
+	if req.HotelID != 0 {
+		builder = builder.Where(squirrel.Eq{"hotel_id": req.HotelID})
+	}
+	if len(req.RoomIDs) > 0 {
+		builder = builder.Where(squirrel.Eq{"room_id": req.RoomIDs})
+	}
+	if req.ExcludeID != 0 {
+		builder = builder.Where(squirrel.NotEq{"id": req.ExcludeID})
+	}
+	query, args, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// stayNights expands every live booking of the hotels overlapping the stay
+// into its nights within the stay, one row per booking and night.
+func stayNights(req *models.LoadRequest) squirrel.SelectBuilder {
+	builder := squirrel.Select().
+		From("booked b, generate_series(b.enterydate, b.leavingdate - 1, interval '1 day') AS night").
+		Where(squirrel.Eq{"b.hotel_id": req.HotelIDs}).
+		Where(squirrel.Lt{"b.enterydate": req.CheckOutDate}).
+		Where(squirrel.Gt{"b.leavingdate": req.CheckInDate}).
+		Where(squirrel.NotEq{"b.status": models.ReleasedStatuses}).
+		Where(squirrel.GtOrEq{"night": req.CheckInDate}).
+		Where(squirrel.Lt{"night": req.CheckOutDate})
+	if req.ExcludeID != 0 {
+		builder = builder.Where(squirrel.NotEq{"b.id": req.ExcludeID})
+	}
+	return builder
+}
+
+// UnassignedLoad selects, per hotel and room type, the most bookings still
+// waiting for a room on a single night of the stay.
+func UnassignedLoad(req *models.LoadRequest) (string, []interface{}, error) {
+	nights := stayNights(req).
+		Columns("b.hotel_id", "b.room_type", "COUNT(*) AS load").
+		Where("b.room_id IS NULL").
+		GroupBy("b.hotel_id", "b.room_type", "night")
+	if req.RoomType != "" {
+		nights = nights.Where(squirrel.Eq{"b.room_type": req.RoomType})
+	}
+	query, args, err := squirrel.Select("hotel_id", "room_type", "MAX(load)").
+		FromSelect(nights, "n").
+		GroupBy("hotel_id", "room_type").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// TypeLoad selects the most rooms of a room type needed on a single night of
+// the stay: bookings holding one of req.RoomIDs plus bookings of req.RoomType
+// still waiting for a room.
+func TypeLoad(req *models.LoadRequest) (string, []interface{}, error) {
+	nights := stayNights(req).
+		Columns("COUNT(*) AS load").
+		Where(squirrel.Or{
+			squirrel.Eq{"b.room_id": req.RoomIDs},
+			squirrel.And{squirrel.Expr("b.room_id IS NULL"), squirrel.Eq{"b.room_type": req.RoomType}},
+		}).
+		GroupBy("night")
+	query, args, err := squirrel.Select("COALESCE(MAX(load), 0)").
+		FromSelect(nights, "n").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// LockRoomType serializes, until the transaction ends, the bookings of one
+// room type so two of them cannot both take its last free room.
+func LockRoomType(hotelID int32, roomType string) (string, []interface{}, error) {
+	query, args, err := squirrel.Select().
+		Column(squirrel.Expr("pg_advisory_xact_lock(hashtext(?))", fmt.Sprintf("booked:%d:%s", hotelID, roomType))).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// AssignRoom gives a room to a booking that has none, only while the booking
+// still waits for its guest.
+func AssignRoom(req *models.AssignRoomRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("booked").
+		Set("room_id", req.RoomID).
+		Where(squirrel.Eq{"id": req.ID, "status": []string{models.StatusPending, models.StatusConfirmed}}).
+		Where("room_id IS NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// ReassignRoom moves a booking to another room, also while the guest stays.
+func ReassignRoom(req *models.AssignRoomRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("booked").
+		Set("room_id", req.RoomID).
+		Where(squirrel.Eq{"id": req.ID, "status": []string{models.StatusPending, models.StatusConfirmed, models.StatusCheckedIn}}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// HotelBookings selects the live bookings of a hotel overlapping the
+// calendar, room by room and in order of arrival.
+func HotelBookings(req *models.CalendarRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select(bookingColumns...).
+		From("booked").
+		Where(squirrel.Eq{"hotel_id": req.HotelID}).
+		Where(squirrel.Lt{"enterydate": req.To}).
+		Where(squirrel.Gt{"leavingdate": req.From}).
+		Where(squirrel.NotEq{"status": models.ReleasedStatuses}).
+		OrderBy("room_id NULLS LAST", "enterydate", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// NightSales selects, per room type and night of the report, the rooms sold
+// and their revenue: the price stored for each night in booked_nights, less
+// the booking's discount. Nights after an early checkout are not sold.
+func NightSales(req *models.ReportRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("b.room_type", "n.night", "COUNT(*)",
+		"COALESCE(SUM(n.price * b.totalcost / NULLIF(b.totalcost + b.discount, 0)), 0)").
+		From("booked b").
+		Join("booked_nights n ON n.booking_id = b.id").
+		Where(squirrel.Eq{"b.hotel_id": req.HotelID, "b.status": models.SoldStatuses}).
+		Where("n.night < b.leavingdate").
+		Where(squirrel.GtOrEq{"n.night": req.From}).
+		Where(squirrel.Lt{"n.night": req.To}).
+		GroupBy("b.room_type", "n.night").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// Arrivals selects, per room type and day of the report, the bookings
+// arriving that day, how many were cancelled and their lead time. Pending
+// bookings are still being made and are left out.
+func Arrivals(req *models.ReportRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("room_type", "enterydate", "COUNT(*)").
+		Column(squirrel.Expr("COUNT(*) FILTER (WHERE status = ?)", models.StatusCancelled)).
+		Column(squirrel.Expr("COALESCE(SUM(enterydate - created_at::date) FILTER (WHERE status <> ? AND created_at IS NOT NULL), 0)", models.StatusCancelled)).
+		Column(squirrel.Expr("COUNT(created_at) FILTER (WHERE status <> ?)", models.StatusCancelled)).
+		From("booked").
+		Where(squirrel.Eq{"hotel_id": req.HotelID}).
+		Where(squirrel.NotEq{"status": models.StatusPending}).
+		Where(squirrel.GtOrEq{"enterydate": req.From}).
+		Where(squirrel.Lt{"enterydate": req.To}).
+		GroupBy("room_type", "enterydate").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// Unassigned selects the live bookings still waiting for a room that arrive
+// before req.Before. Earlier arrivals come first and, on the same day,
+// longer stays, which are the hardest to fit.
+func Unassigned(req *models.UnassignedRequest) (string, []interface{}, error) {
+	builder := squirrel.Select(bookingColumns...).
+		From("booked").
+		Where("room_id IS NULL").
+		Where(squirrel.Eq{"status": []string{models.StatusPending, models.StatusConfirmed}}).
+		Where(squirrel.Lt{"enterydate": req.Before}).
+		OrderBy("enterydate", "leavingdate - enterydate DESC", "id")
+	if req.Limit > 0 {
+		builder = builder.Limit(uint64(req.Limit))
+	}
+	query, args, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// Neighbours selects, per room, the end of the last live booking before the
+// stay and the start of the first one after it. Rooms with neither are left out.
+func Neighbours(req *models.RoomNeighbours) (string, []interface{}, error) {
+	builder := squirrel.Select("room_id").
+		Column(squirrel.Expr("MAX(leavingdate) FILTER (WHERE leavingdate <= ?)", req.CheckInDate)).
+		Column(squirrel.Expr("MIN(enterydate) FILTER (WHERE enterydate >= ?)", req.CheckOutDate)).
+		From("booked").
+		Where(squirrel.Eq{"hotel_id": req.HotelID, "room_id": req.RoomIDs}).
+		Where(squirrel.NotEq{"status": models.ReleasedStatuses}).
+		Where(squirrel.Or{squirrel.LtOrEq{"leavingdate": req.CheckInDate}, squirrel.GtOrEq{"enterydate": req.CheckOutDate}}).
+		GroupBy("room_id")
+	if req.ExcludeID != 0 {
+		builder = builder.Where(squirrel.NotEq{"id": req.ExcludeID})
+	}
+	query, args, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// CreateNights stores the price of every night of a booking as it was quoted,
+// so later rate changes do not rewrite what the guest was charged.
+func CreateNights(bookingID int32, nights []*models.NightPrice) (string, []interface{}, error) {
+	builder := squirrel.Insert("booked_nights").Columns("booking_id", "night", "price")
+	for _, v := range nights {
+		builder = builder.Values(bookingID, v.Date, v.Price)
+	}
+	query, args, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetNights(bookingID int32) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("night", "price").
+		From("booked_nights").
+		Where(squirrel.Eq{"booking_id": bookingID}).
+		OrderBy("night").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteNights(bookingID int32) (string, []interface{}, error) {
+	query, args, err := squirrel.Delete("booked_nights").
+		Where(squirrel.Eq{"booking_id": bookingID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreatePayment(req *models.Payment) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("payments").
+		Columns("booking_id", "provider", "provider_ref", "status", "amount").
+		Values(req.BookingID, req.Provider, req.ProviderRef, req.Status, req.Amount).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// GetPayment selects the current payment of a booking. A booking gets a new
+// payment when its price grows, so live payments come before declined and
+// voided ones, newest first.
+func GetPayment(req *models.GetUsersBookRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id", "booking_id", "provider", "provider_ref", "status", "amount", "captured_amount", "refunded_amount").
+		From("payments").
+		Where(squirrel.Eq{"booking_id": req.ID}).
+		OrderBy("status IN ('declined', 'voided')", "id DESC").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// UpdatePayment changes a payment only while it is still in req.From.
+func UpdatePayment(req *models.UpdatePaymentRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("payments").
+		SetMap(map[string]interface{}{
+			"status":          req.To,
+			"captured_amount": req.CapturedAmount,
+			"refunded_amount": req.RefundedAmount,
+			"updated_at":      squirrel.Expr("NOW()"),
+		}).
+		Where(squirrel.Eq{"id": req.ID, "status": req.From}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreatePaymentEvent(req *models.PaymentEvent) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("payment_events").
+		Columns("payment_id", "from_status", "to_status", "amount", "error").
+		Values(req.PaymentID, req.FromStatus, req.ToStatus, req.Amount, req.Error).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetPaymentEvents(paymentID int32) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("payment_id", "from_status", "to_status", "amount", "error", "created_at").
+		From("payment_events").
+		Where(squirrel.Eq{"payment_id": paymentID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateSaga(req *models.Saga) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("sagas").
+		Columns("kind", "status", "step", "state").
+		Values(req.Kind, req.Status, req.Step, string(req.State)).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func UpdateSaga(req *models.Saga) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("sagas").
+		SetMap(map[string]interface{}{
+			"status":     req.Status,
+			"step":       req.Step,
+			"state":      string(req.State),
+			"error":      req.Error,
+			"updated_at": squirrel.Expr("NOW()"),
+		}).
+		Where(squirrel.Eq{"id": req.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// PendingSagas selects sagas that were interrupted before they finished.
+func PendingSagas() (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id", "kind", "status", "step", "state", "error").
+		From("sagas").
+		Where(squirrel.Eq{"status": []string{models.SagaRunning, models.SagaCompensating}}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateSagaStep(req *models.SagaStep) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("saga_steps").
+		Columns("saga_id", "name", "action", "error").
+		Values(req.SagaID, req.Name, req.Action, req.Error).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// SaveCommand records the result of a command. A command redelivered by
+// Kafka overwrites its earlier result.
+func SaveCommand(req *models.Command) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("commands").
+		Columns("id", "kind", "user_id", "status", "entity_id", "message", "error").
+		Values(req.ID, req.Kind, req.UserID, req.Status, req.EntityID, req.Message, req.Error).
+		Suffix("ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, entity_id = EXCLUDED.entity_id, message = EXCLUDED.message, error = EXCLUDED.error, updated_at = NOW()").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetCommand(req *models.GetCommandRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id", "kind", "user_id", "status", "entity_id", "message", "error", "created_at", "updated_at").
+		From("commands").
+		Where(squirrel.Eq{"id": req.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// SaveProcessedKey keeps the first result stored for a key.
+func SaveProcessedKey(req *models.ProcessedKey) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("processed_keys").
+		Columns("key", "kind", "command_id", "entity_id", "message").
+		Values(req.Key, req.Kind, req.CommandID, req.EntityID, req.Message).
+		Suffix("ON CONFLICT (key) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetProcessedKey(key string) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("key", "kind", "command_id", "entity_id", "message").
+		From("processed_keys").
+		Where(squirrel.Eq{"key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// LegacyHolds selects the rooms the old booking flow left unavailable.
+func LegacyHolds() (string, []interface{}, error) {
+	query, args, err := squirrel.Select("hotel_id", "room_id").
+		From("legacy_room_holds").
+		OrderBy("hotel_id", "room_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteLegacyHold(req *models.RoomHold) (string, []interface{}, error) {
+	query, args, err := squirrel.Delete("legacy_room_holds").
+		Where(squirrel.Eq{"hotel_id": req.HotelID, "room_id": req.RoomID}).
+		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		log.Println(err)
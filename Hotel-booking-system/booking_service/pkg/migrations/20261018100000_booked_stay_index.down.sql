@@ -0,0 +1 @@
+DROP INDEX IF EXISTS booked_room_stay_idx;
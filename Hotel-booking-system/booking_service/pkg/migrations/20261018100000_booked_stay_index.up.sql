@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS booked_room_stay_idx ON booked (hotel_id, room_id, enterydate, leavingdate);
@@ -0,0 +1 @@
+ALTER TABLE booked DROP CONSTRAINT IF EXISTS booked_no_overlap;
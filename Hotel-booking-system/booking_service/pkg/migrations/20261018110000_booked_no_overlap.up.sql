@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE booked
+    ADD CONSTRAINT booked_no_overlap
+    EXCLUDE USING gist (room_id WITH =, daterange(enterydate, leavingdate, '[)') WITH &&);
@@ -0,0 +1,11 @@
+ALTER TABLE booked DROP CONSTRAINT IF EXISTS booked_no_overlap;
+ALTER TABLE booked
+    ADD CONSTRAINT booked_no_overlap
+    EXCLUDE USING gist (room_id WITH =, daterange(enterydate, leavingdate, '[)') WITH &&);
+
+ALTER TABLE booked DROP CONSTRAINT IF EXISTS booked_status_check;
+ALTER TABLE booked ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE booked ALTER COLUMN status DROP DEFAULT;
+
+UPDATE booked SET status = 'Guest Living' WHERE status = 'checked_in';
+UPDATE booked SET status = 'Booked' WHERE status IN ('pending', 'confirmed');
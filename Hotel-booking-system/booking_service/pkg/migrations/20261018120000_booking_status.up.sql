@@ -0,0 +1,15 @@
+UPDATE booked SET status = 'confirmed' WHERE status IN ('Booked', 'updated') OR status IS NULL;
+UPDATE booked SET status = 'checked_in' WHERE status = 'Guest Living';
+
+ALTER TABLE booked ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE booked ALTER COLUMN status SET NOT NULL;
+ALTER TABLE booked
+    ADD CONSTRAINT booked_status_check
+    CHECK (status IN ('pending', 'confirmed', 'checked_in', 'checked_out', 'cancelled', 'no_show'));
+
+-- Cancelled bookings and no-shows no longer hold their room
+ALTER TABLE booked DROP CONSTRAINT IF EXISTS booked_no_overlap;
+ALTER TABLE booked
+    ADD CONSTRAINT booked_no_overlap
+    EXCLUDE USING gist (room_id WITH =, daterange(enterydate, leavingdate, '[)') WITH &&)
+    WHERE (status NOT IN ('cancelled', 'no_show'));
@@ -0,0 +1,5 @@
+ALTER TABLE booked
+    DROP COLUMN IF EXISTS penalty_amount,
+    DROP COLUMN IF EXISTS refund_amount,
+    DROP COLUMN IF EXISTS cancel_reason,
+    DROP COLUMN IF EXISTS cancelled_at;
@@ -0,0 +1,5 @@
+ALTER TABLE booked
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS cancel_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS refund_amount FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS penalty_amount FLOAT NOT NULL DEFAULT 0;
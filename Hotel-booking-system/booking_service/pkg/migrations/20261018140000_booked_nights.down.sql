@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS booked_nights;
+ALTER TABLE booked DROP COLUMN IF EXISTS discount;
@@ -0,0 +1,15 @@
+ALTER TABLE booked ADD COLUMN IF NOT EXISTS discount FLOAT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS booked_nights (
+    booking_id INT NOT NULL REFERENCES booked(id) ON DELETE CASCADE,
+    night DATE NOT NULL,
+    price FLOAT NOT NULL,
+    PRIMARY KEY (booking_id, night)
+);
+
+-- Existing bookings were priced with one rate, spread it evenly over their nights
+INSERT INTO booked_nights (booking_id, night, price)
+SELECT b.id, d::date, b.totalcost / (b.leavingdate - b.enterydate)
+FROM booked b, generate_series(b.enterydate, b.leavingdate - 1, interval '1 day') AS d
+WHERE b.leavingdate > b.enterydate
+ON CONFLICT DO NOTHING;
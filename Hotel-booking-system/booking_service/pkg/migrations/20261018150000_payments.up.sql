@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS payments (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES booked(id) ON DELETE CASCADE,
+    provider VARCHAR NOT NULL,
+    provider_ref VARCHAR NOT NULL DEFAULT '',
+    status VARCHAR NOT NULL CHECK (status IN ('authorized', 'declined', 'captured', 'voided', 'refunded')),
+    amount FLOAT NOT NULL,
+    captured_amount FLOAT NOT NULL DEFAULT 0,
+    refunded_amount FLOAT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payments_booking_idx ON payments (booking_id);
+
+CREATE TABLE IF NOT EXISTS payment_events (
+    id SERIAL PRIMARY KEY,
+    payment_id INT NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    from_status VARCHAR NOT NULL DEFAULT '',
+    to_status VARCHAR NOT NULL,
+    amount FLOAT NOT NULL DEFAULT 0,
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS sagas (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR NOT NULL,
+    status VARCHAR NOT NULL CHECK (status IN ('running', 'completed', 'compensating', 'compensated', 'failed')),
+    step INT NOT NULL DEFAULT 0,
+    state JSONB NOT NULL DEFAULT '{}',
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Only unfinished sagas are looked up, on startup
+CREATE INDEX IF NOT EXISTS sagas_pending_idx ON sagas (id) WHERE status IN ('running', 'compensating');
+
+CREATE TABLE IF NOT EXISTS saga_steps (
+    id SERIAL PRIMARY KEY,
+    saga_id INT NOT NULL REFERENCES sagas(id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL,
+    action VARCHAR NOT NULL CHECK (action IN ('do', 'compensate')),
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS commands (
+    id VARCHAR(36) PRIMARY KEY,
+    kind VARCHAR NOT NULL,
+    status VARCHAR NOT NULL CHECK (status IN ('succeeded', 'failed')),
+    entity_id INT NOT NULL DEFAULT 0,
+    message VARCHAR NOT NULL DEFAULT '',
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS waitinglist_offers_idx;
+DROP INDEX IF EXISTS waitinglist_queue_idx;
+DROP INDEX IF EXISTS waitinglist_open_email_idx;
+ALTER TABLE waitinglist ADD CONSTRAINT waitinglist_user_email_key UNIQUE (user_email);
+
+ALTER TABLE waitinglist DROP CONSTRAINT IF EXISTS waitinglist_status_check;
+ALTER TABLE waitinglist ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE waitinglist ALTER COLUMN status DROP DEFAULT;
+
+ALTER TABLE waitinglist
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS offer_expires_at,
+    DROP COLUMN IF EXISTS booking_id;
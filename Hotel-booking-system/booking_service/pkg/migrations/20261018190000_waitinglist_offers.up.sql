@@ -0,0 +1,19 @@
+ALTER TABLE waitinglist
+    ADD COLUMN IF NOT EXISTS booking_id INT REFERENCES booked(id),
+    ADD COLUMN IF NOT EXISTS offer_expires_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE waitinglist SET status = 'waiting' WHERE status IS NULL OR status = 'updated';
+ALTER TABLE waitinglist ALTER COLUMN status SET DEFAULT 'waiting';
+ALTER TABLE waitinglist ALTER COLUMN status SET NOT NULL;
+ALTER TABLE waitinglist
+    ADD CONSTRAINT waitinglist_status_check
+    CHECK (status IN ('waiting', 'offered', 'accepted', 'expired'));
+
+-- Accepted and expired entries are kept, only open ones must be unique per email
+ALTER TABLE waitinglist DROP CONSTRAINT IF EXISTS waitinglist_user_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS waitinglist_open_email_idx ON waitinglist (user_email) WHERE status IN ('waiting', 'offered');
+
+-- The queue of a room type is read in arrival order
+CREATE INDEX IF NOT EXISTS waitinglist_queue_idx ON waitinglist (hotel_id, room_type, created_at) WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS waitinglist_offers_idx ON waitinglist (offer_expires_at) WHERE status = 'offered';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS booked_unassigned_idx;
@@ -0,0 +1,6 @@
+-- Bookings of a room type get their room later, until then room_id is NULL.
+-- NULL never conflicts in booked_no_overlap, the service checks the type's load instead.
+UPDATE booked SET room_id = NULL WHERE room_id = 0;
+
+CREATE INDEX IF NOT EXISTS booked_unassigned_idx ON booked (hotel_id, room_type, enterydate, leavingdate)
+    WHERE room_id IS NULL AND status NOT IN ('cancelled', 'no_show');
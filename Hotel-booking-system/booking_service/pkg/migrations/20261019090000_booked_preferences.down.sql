@@ -0,0 +1,2 @@
+ALTER TABLE booked DROP COLUMN IF EXISTS room_amenities;
+ALTER TABLE booked DROP COLUMN IF EXISTS preferred_floor;
@@ -0,0 +1,3 @@
+-- What the guest asks of the room picked for a booking made by room type.
+ALTER TABLE booked ADD COLUMN IF NOT EXISTS preferred_floor INTEGER;
+ALTER TABLE booked ADD COLUMN IF NOT EXISTS room_amenities TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS booked_arrival_idx;
+ALTER TABLE booked DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,11 @@
+-- When the booking was made, for the lead time in reports. Older bookings
+-- didn't record it; their first payment is the closest record there is.
+ALTER TABLE booked ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ;
+
+UPDATE booked b SET created_at = p.created_at
+FROM (SELECT booking_id, MIN(created_at) AS created_at FROM payments GROUP BY booking_id) p
+WHERE p.booking_id = b.id AND b.created_at IS NULL;
+
+ALTER TABLE booked ALTER COLUMN created_at SET DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS booked_arrival_idx ON booked (hotel_id, enterydate);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS waitinglist_hotel_list_idx;
+DROP INDEX IF EXISTS waitinglist_user_list_idx;
+DROP INDEX IF EXISTS booked_hotel_list_idx;
+DROP INDEX IF EXISTS booked_user_list_idx;
@@ -0,0 +1,6 @@
+-- Keyset pages of a user's or a hotel's bookings and waiting list entries,
+-- ordered by check-in date and ID.
+CREATE INDEX IF NOT EXISTS booked_user_list_idx ON booked (user_id, enterydate, id);
+CREATE INDEX IF NOT EXISTS booked_hotel_list_idx ON booked (hotel_id, enterydate, id);
+CREATE INDEX IF NOT EXISTS waitinglist_user_list_idx ON waitinglist (user_id, enterydate, id);
+CREATE INDEX IF NOT EXISTS waitinglist_hotel_list_idx ON waitinglist (hotel_id, enterydate, id);
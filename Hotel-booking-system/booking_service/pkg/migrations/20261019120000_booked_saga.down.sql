@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS booked_saga_idx;
+ALTER TABLE booked DROP COLUMN IF EXISTS saga_id;
@@ -0,0 +1,5 @@
+-- The booking saga that made the booking. A saga resumed after a restart
+-- looks its booking up here instead of making a second one.
+ALTER TABLE booked ADD COLUMN IF NOT EXISTS saga_id INT REFERENCES sagas (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS booked_saga_idx ON booked (saga_id) WHERE saga_id IS NOT NULL;
@@ -0,0 +1,3 @@
+-- The user who issued the command; only they and admins may read its
+-- result. Older commands belong to nobody and are left to admins.
+ALTER TABLE commands ADD COLUMN IF NOT EXISTS user_id INT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS legacy_room_holds;
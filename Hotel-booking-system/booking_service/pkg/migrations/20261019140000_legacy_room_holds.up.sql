@@ -0,0 +1,15 @@
+-- The old booking flow marked the room of every booking unavailable in the
+-- hotel service and only made it available again on cancellation. These are
+-- the rooms it left that way; ReleaseLegacyHolds makes them available once
+-- and empties the table. Rooms staff took out of service have no booking
+-- here and keep their flag.
+CREATE TABLE IF NOT EXISTS legacy_room_holds (
+    hotel_id INT NOT NULL,
+    room_id INT NOT NULL,
+    PRIMARY KEY (hotel_id, room_id)
+);
+
+INSERT INTO legacy_room_holds (hotel_id, room_id)
+SELECT DISTINCT hotel_id, room_id FROM booked
+WHERE room_id IS NOT NULL AND status NOT IN ('cancelled', 'no_show')
+ON CONFLICT DO NOTHING;
@@ -19,15 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookHotel_Create_FullMethodName         = "/BookHotel/Create"
-	BookHotel_Get_FullMethodName            = "/BookHotel/Get"
-	BookHotel_Update_FullMethodName         = "/BookHotel/Update"
-	BookHotel_Delete_FullMethodName         = "/BookHotel/Delete"
-	BookHotel_CreateWaiting_FullMethodName  = "/BookHotel/CreateWaiting"
-	BookHotel_GetWaitinglist_FullMethodName = "/BookHotel/GetWaitinglist"
-	BookHotel_Getall_FullMethodName         = "/BookHotel/Getall"
-	BookHotel_UpdateWaiting_FullMethodName  = "/BookHotel/UpdateWaiting"
-	BookHotel_CancelWaiting_FullMethodName  = "/BookHotel/CancelWaiting"
+	BookHotel_Create_FullMethodName               = "/BookHotel/Create"
+	BookHotel_Get_FullMethodName                  = "/BookHotel/Get"
+	BookHotel_Update_FullMethodName               = "/BookHotel/Update"
+	BookHotel_Delete_FullMethodName               = "/BookHotel/Delete"
+	BookHotel_CreateWaiting_FullMethodName        = "/BookHotel/CreateWaiting"
+	BookHotel_GetWaitinglist_FullMethodName       = "/BookHotel/GetWaitinglist"
+	BookHotel_Getall_FullMethodName               = "/BookHotel/Getall"
+	BookHotel_ListBookings_FullMethodName         = "/BookHotel/ListBookings"
+	BookHotel_ListWaitingList_FullMethodName      = "/BookHotel/ListWaitingList"
+	BookHotel_UpdateWaiting_FullMethodName        = "/BookHotel/UpdateWaiting"
+	BookHotel_CancelWaiting_FullMethodName        = "/BookHotel/CancelWaiting"
+	BookHotel_CheckAvailability_FullMethodName    = "/BookHotel/CheckAvailability"
+	BookHotel_CheckIn_FullMethodName              = "/BookHotel/CheckIn"
+	BookHotel_CheckOut_FullMethodName             = "/BookHotel/CheckOut"
+	BookHotel_MarkNoShow_FullMethodName           = "/BookHotel/MarkNoShow"
+	BookHotel_GetPayment_FullMethodName           = "/BookHotel/GetPayment"
+	BookHotel_GetCommandStatus_FullMethodName     = "/BookHotel/GetCommandStatus"
+	BookHotel_AcceptOffer_FullMethodName          = "/BookHotel/AcceptOffer"
+	BookHotel_SearchAvailability_FullMethodName   = "/BookHotel/SearchAvailability"
+	BookHotel_AssignRoom_FullMethodName           = "/BookHotel/AssignRoom"
+	BookHotel_ReassignRoom_FullMethodName         = "/BookHotel/ReassignRoom"
+	BookHotel_GetOccupancyCalendar_FullMethodName = "/BookHotel/GetOccupancyCalendar"
+	BookHotel_GetReport_FullMethodName            = "/BookHotel/GetReport"
 )
 
 // BookHotelClient is the client API for BookHotel service.
@@ -40,9 +54,24 @@ type BookHotelClient interface {
 	Delete(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*GeneralResponse, error)
 	CreateWaiting(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*GeneralResponse, error)
 	GetWaitinglist(ctx context.Context, in *GetWaitinglistRequest, opts ...grpc.CallOption) (*GetWaitinglistResponse, error)
+	// Getall returns the whole waiting list, prefer the paged ListWaitingList.
 	Getall(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	ListBookings(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	ListWaitingList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListWaitingListResponse, error)
 	UpdateWaiting(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*GeneralResponse, error)
 	CancelWaiting(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*GeneralResponse, error)
+	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
+	CheckIn(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	CheckOut(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	MarkNoShow(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetPayment(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*Payment, error)
+	GetCommandStatus(ctx context.Context, in *GetCommandStatusRequest, opts ...grpc.CallOption) (*CommandStatus, error)
+	AcceptOffer(ctx context.Context, in *AcceptOfferRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	SearchAvailability(ctx context.Context, in *SearchAvailabilityRequest, opts ...grpc.CallOption) (*SearchAvailabilityResponse, error)
+	AssignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	ReassignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetOccupancyCalendar(ctx context.Context, in *GetOccupancyCalendarRequest, opts ...grpc.CallOption) (*OccupancyCalendar, error)
+	GetReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*Report, error)
 }
 
 type bookHotelClient struct {
@@ -123,6 +152,26 @@ func (c *bookHotelClient) Getall(ctx context.Context, in *Request, opts ...grpc.
 	return out, nil
 }
 
+func (c *bookHotelClient) ListBookings(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, BookHotel_ListBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) ListWaitingList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListWaitingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWaitingListResponse)
+	err := c.cc.Invoke(ctx, BookHotel_ListWaitingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookHotelClient) UpdateWaiting(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*GeneralResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GeneralResponse)
@@ -143,6 +192,126 @@ func (c *bookHotelClient) CancelWaiting(ctx context.Context, in *Bytes, opts ...
 	return out, nil
 }
 
+func (c *bookHotelClient) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAvailabilityResponse)
+	err := c.cc.Invoke(ctx, BookHotel_CheckAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) CheckIn(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) CheckOut(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_CheckOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) MarkNoShow(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_MarkNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) GetPayment(ctx context.Context, in *GetUsersBookRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, BookHotel_GetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) GetCommandStatus(ctx context.Context, in *GetCommandStatusRequest, opts ...grpc.CallOption) (*CommandStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandStatus)
+	err := c.cc.Invoke(ctx, BookHotel_GetCommandStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) AcceptOffer(ctx context.Context, in *AcceptOfferRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_AcceptOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) SearchAvailability(ctx context.Context, in *SearchAvailabilityRequest, opts ...grpc.CallOption) (*SearchAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAvailabilityResponse)
+	err := c.cc.Invoke(ctx, BookHotel_SearchAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) AssignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_AssignRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) ReassignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, BookHotel_ReassignRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) GetOccupancyCalendar(ctx context.Context, in *GetOccupancyCalendarRequest, opts ...grpc.CallOption) (*OccupancyCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OccupancyCalendar)
+	err := c.cc.Invoke(ctx, BookHotel_GetOccupancyCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookHotelClient) GetReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*Report, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Report)
+	err := c.cc.Invoke(ctx, BookHotel_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookHotelServer is the server API for BookHotel service.
 // All implementations must embed UnimplementedBookHotelServer
 // for forward compatibility.
@@ -153,9 +322,24 @@ type BookHotelServer interface {
 	Delete(context.Context, *Bytes) (*GeneralResponse, error)
 	CreateWaiting(context.Context, *Bytes) (*GeneralResponse, error)
 	GetWaitinglist(context.Context, *GetWaitinglistRequest) (*GetWaitinglistResponse, error)
+	// Getall returns the whole waiting list, prefer the paged ListWaitingList.
 	Getall(context.Context, *Request) (*Response, error)
+	ListBookings(context.Context, *ListRequest) (*ListBookingsResponse, error)
+	ListWaitingList(context.Context, *ListRequest) (*ListWaitingListResponse, error)
 	UpdateWaiting(context.Context, *Bytes) (*GeneralResponse, error)
 	CancelWaiting(context.Context, *Bytes) (*GeneralResponse, error)
+	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
+	CheckIn(context.Context, *GetUsersBookRequest) (*GeneralResponse, error)
+	CheckOut(context.Context, *GetUsersBookRequest) (*GeneralResponse, error)
+	MarkNoShow(context.Context, *GetUsersBookRequest) (*GeneralResponse, error)
+	GetPayment(context.Context, *GetUsersBookRequest) (*Payment, error)
+	GetCommandStatus(context.Context, *GetCommandStatusRequest) (*CommandStatus, error)
+	AcceptOffer(context.Context, *AcceptOfferRequest) (*GeneralResponse, error)
+	SearchAvailability(context.Context, *SearchAvailabilityRequest) (*SearchAvailabilityResponse, error)
+	AssignRoom(context.Context, *AssignRoomRequest) (*GeneralResponse, error)
+	ReassignRoom(context.Context, *AssignRoomRequest) (*GeneralResponse, error)
+	GetOccupancyCalendar(context.Context, *GetOccupancyCalendarRequest) (*OccupancyCalendar, error)
+	GetReport(context.Context, *ReportRequest) (*Report, error)
 	mustEmbedUnimplementedBookHotelServer()
 }
 
@@ -187,12 +371,54 @@ func (UnimplementedBookHotelServer) GetWaitinglist(context.Context, *GetWaitingl
 func (UnimplementedBookHotelServer) Getall(context.Context, *Request) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Getall not implemented")
 }
+func (UnimplementedBookHotelServer) ListBookings(context.Context, *ListRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedBookHotelServer) ListWaitingList(context.Context, *ListRequest) (*ListWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitingList not implemented")
+}
 func (UnimplementedBookHotelServer) UpdateWaiting(context.Context, *Bytes) (*GeneralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWaiting not implemented")
 }
 func (UnimplementedBookHotelServer) CancelWaiting(context.Context, *Bytes) (*GeneralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelWaiting not implemented")
 }
+func (UnimplementedBookHotelServer) CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
+}
+func (UnimplementedBookHotelServer) CheckIn(context.Context, *GetUsersBookRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedBookHotelServer) CheckOut(context.Context, *GetUsersBookRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOut not implemented")
+}
+func (UnimplementedBookHotelServer) MarkNoShow(context.Context, *GetUsersBookRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (UnimplementedBookHotelServer) GetPayment(context.Context, *GetUsersBookRequest) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedBookHotelServer) GetCommandStatus(context.Context, *GetCommandStatusRequest) (*CommandStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandStatus not implemented")
+}
+func (UnimplementedBookHotelServer) AcceptOffer(context.Context, *AcceptOfferRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOffer not implemented")
+}
+func (UnimplementedBookHotelServer) SearchAvailability(context.Context, *SearchAvailabilityRequest) (*SearchAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAvailability not implemented")
+}
+func (UnimplementedBookHotelServer) AssignRoom(context.Context, *AssignRoomRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRoom not implemented")
+}
+func (UnimplementedBookHotelServer) ReassignRoom(context.Context, *AssignRoomRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignRoom not implemented")
+}
+func (UnimplementedBookHotelServer) GetOccupancyCalendar(context.Context, *GetOccupancyCalendarRequest) (*OccupancyCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupancyCalendar not implemented")
+}
+func (UnimplementedBookHotelServer) GetReport(context.Context, *ReportRequest) (*Report, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
 func (UnimplementedBookHotelServer) mustEmbedUnimplementedBookHotelServer() {}
 func (UnimplementedBookHotelServer) testEmbeddedByValue()                   {}
 
@@ -340,6 +566,42 @@ func _BookHotel_Getall_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookHotel_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_ListBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).ListBookings(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_ListWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).ListWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_ListWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).ListWaitingList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookHotel_UpdateWaiting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Bytes)
 	if err := dec(in); err != nil {
@@ -376,6 +638,222 @@ func _BookHotel_CancelWaiting_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookHotel_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).CheckAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_CheckAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).CheckAvailability(ctx, req.(*CheckAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).CheckIn(ctx, req.(*GetUsersBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_CheckOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).CheckOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_CheckOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).CheckOut(ctx, req.(*GetUsersBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_MarkNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).MarkNoShow(ctx, req.(*GetUsersBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_GetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).GetPayment(ctx, req.(*GetUsersBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_GetCommandStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).GetCommandStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_GetCommandStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).GetCommandStatus(ctx, req.(*GetCommandStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_AcceptOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).AcceptOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_AcceptOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).AcceptOffer(ctx, req.(*AcceptOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_SearchAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).SearchAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_SearchAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).SearchAvailability(ctx, req.(*SearchAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_AssignRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).AssignRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_AssignRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).AssignRoom(ctx, req.(*AssignRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_ReassignRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).ReassignRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_ReassignRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).ReassignRoom(ctx, req.(*AssignRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_GetOccupancyCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupancyCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).GetOccupancyCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_GetOccupancyCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).GetOccupancyCalendar(ctx, req.(*GetOccupancyCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookHotel_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookHotelServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookHotel_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookHotelServer).GetReport(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookHotel_ServiceDesc is the grpc.ServiceDesc for BookHotel service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +889,14 @@ var BookHotel_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Getall",
 			Handler:    _BookHotel_Getall_Handler,
 		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _BookHotel_ListBookings_Handler,
+		},
+		{
+			MethodName: "ListWaitingList",
+			Handler:    _BookHotel_ListWaitingList_Handler,
+		},
 		{
 			MethodName: "UpdateWaiting",
 			Handler:    _BookHotel_UpdateWaiting_Handler,
@@ -419,6 +905,54 @@ var BookHotel_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelWaiting",
 			Handler:    _BookHotel_CancelWaiting_Handler,
 		},
+		{
+			MethodName: "CheckAvailability",
+			Handler:    _BookHotel_CheckAvailability_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookHotel_CheckIn_Handler,
+		},
+		{
+			MethodName: "CheckOut",
+			Handler:    _BookHotel_CheckOut_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _BookHotel_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _BookHotel_GetPayment_Handler,
+		},
+		{
+			MethodName: "GetCommandStatus",
+			Handler:    _BookHotel_GetCommandStatus_Handler,
+		},
+		{
+			MethodName: "AcceptOffer",
+			Handler:    _BookHotel_AcceptOffer_Handler,
+		},
+		{
+			MethodName: "SearchAvailability",
+			Handler:    _BookHotel_SearchAvailability_Handler,
+		},
+		{
+			MethodName: "AssignRoom",
+			Handler:    _BookHotel_AssignRoom_Handler,
+		},
+		{
+			MethodName: "ReassignRoom",
+			Handler:    _BookHotel_ReassignRoom_Handler,
+		},
+		{
+			MethodName: "GetOccupancyCalendar",
+			Handler:    _BookHotel_GetOccupancyCalendar_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _BookHotel_GetReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
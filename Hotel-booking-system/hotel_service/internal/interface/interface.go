@@ -17,6 +17,23 @@ type Hotel interface{
 	GetRooms(ctx context.Context,req *models.GetRoomRequest)(*models.GetRoomResponse,error)
 	UpdateRooms(ctx context.Context,req *models.UpdateRoomRequest)(*models.GeneralResponse,error)
 	DeleteRoom(ctx context.Context,req *models.GetRoomRequest)(*models.GeneralResponse,error)
+	SetCancellationPolicy(ctx context.Context,req *models.CancellationPolicy)(*models.GeneralResponse,error)
+	GetCancellationPolicy(ctx context.Context,req *models.GetHotelRequest)(*models.CancellationPolicy,error)
+	CreateRate(ctx context.Context,req *models.Rate)(*models.GeneralResponse,error)
+	DeleteRate(ctx context.Context,req *models.DeleteRateRequest)(*models.GeneralResponse,error)
+	CreateStayDiscount(ctx context.Context,req *models.StayDiscount)(*models.GeneralResponse,error)
+	DeleteStayDiscount(ctx context.Context,req *models.DeleteRateRequest)(*models.GeneralResponse,error)
+	GetRatePlan(ctx context.Context,req *models.RatePlanRequest)(*models.RatePlan,error)
+	SearchRooms(ctx context.Context,req *models.SearchHotelsRequest)([]*models.SearchHotel,error)
+	NearbyHotels(ctx context.Context,req *models.NearbyHotelsRequest)([]*models.NearbyHotel,error)
+	CreateAmenity(ctx context.Context,req *models.Amenity)(*models.GeneralResponse,error)
+	ListAmenities(ctx context.Context,req *models.ListAmenitiesRequest)([]*models.Amenity,error)
+	SetAmenities(ctx context.Context,req *models.SetAmenitiesRequest)(*models.GeneralResponse,error)
+	CreateRoomType(ctx context.Context,req *models.RoomType)(*models.GeneralResponse,error)
+	GetRoomTypes(ctx context.Context,req *models.GetHotelRequest)([]*models.RoomType,error)
+	GetRoomType(ctx context.Context,req *models.GetRoomTypeRequest)(*models.RoomType,error)
+	UpdateRoomType(ctx context.Context,req *models.RoomType)(*models.GeneralResponse,error)
+	DeleteRoomType(ctx context.Context,req *models.DeleteRateRequest)(*models.GeneralResponse,error)
 }
 
 type Adjust interface{
@@ -30,4 +47,22 @@ type Adjust interface{
 	GetRooms(ctx context.Context,req *hotel.GetroomRequest)(*hotel.GetroomResponse,error)
 	UpdateRooms(ctx context.Context,req *hotel.UpdateRoomRequest)(*hotel.GeneralResponse,error)
 	DeleteRoom(ctx context.Context,req *hotel.GetroomRequest)(*hotel.GeneralResponse,error)
+	SetCancellationPolicy(ctx context.Context,req *hotel.CancellationPolicy)(*hotel.GeneralResponse,error)
+	GetCancellationPolicy(ctx context.Context,req *hotel.GetHotelRequest)(*hotel.CancellationPolicy,error)
+	CreateRate(ctx context.Context,req *hotel.Rate)(*hotel.GeneralResponse,error)
+	DeleteRate(ctx context.Context,req *hotel.DeleteRateRequest)(*hotel.GeneralResponse,error)
+	CreateStayDiscount(ctx context.Context,req *hotel.StayDiscount)(*hotel.GeneralResponse,error)
+	DeleteStayDiscount(ctx context.Context,req *hotel.DeleteRateRequest)(*hotel.GeneralResponse,error)
+	GetRatePlan(ctx context.Context,req *hotel.RatePlanRequest)(*hotel.RatePlan,error)
+	Quote(ctx context.Context,req *hotel.QuoteRequest)(*hotel.QuoteResponse,error)
+	SearchHotels(ctx context.Context,req *hotel.SearchHotelsRequest)(*hotel.SearchHotelsResponse,error)
+	NearbyHotels(ctx context.Context,req *hotel.NearbyHotelsRequest)(*hotel.NearbyHotelsResponse,error)
+	CreateAmenity(ctx context.Context,req *hotel.Amenity)(*hotel.GeneralResponse,error)
+	ListAmenities(ctx context.Context,req *hotel.ListAmenitiesRequest)(*hotel.ListAmenitiesResponse,error)
+	SetHotelAmenities(ctx context.Context,req *hotel.SetAmenitiesRequest)(*hotel.GeneralResponse,error)
+	SetRoomAmenities(ctx context.Context,req *hotel.SetAmenitiesRequest)(*hotel.GeneralResponse,error)
+	CreateRoomType(ctx context.Context,req *hotel.RoomType)(*hotel.GeneralResponse,error)
+	GetRoomTypes(ctx context.Context,req *hotel.GetHotelRequest)(*hotel.RoomTypesResponse,error)
+	UpdateRoomType(ctx context.Context,req *hotel.RoomType)(*hotel.GeneralResponse,error)
+	DeleteRoomType(ctx context.Context,req *hotel.DeleteRateRequest)(*hotel.GeneralResponse,error)
 }
\ No newline at end of file
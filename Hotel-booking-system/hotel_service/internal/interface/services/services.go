@@ -55,6 +55,74 @@ func (u *Database) DeleteRoom(ctx context.Context, req *models.GetRoomRequest) (
 	return u.S.DeleteRoom(ctx, req)
 }
 
+func (u *Database) SetCancellationPolicy(ctx context.Context, req *models.CancellationPolicy) (*models.GeneralResponse, error) {
+	return u.S.SetCancellationPolicy(ctx, req)
+}
+
+func (u *Database) GetCancellationPolicy(ctx context.Context, req *models.GetHotelRequest) (*models.CancellationPolicy, error) {
+	return u.S.GetCancellationPolicy(ctx, req)
+}
+
+func (u *Database) CreateRate(ctx context.Context, req *models.Rate) (*models.GeneralResponse, error) {
+	return u.S.CreateRate(ctx, req)
+}
+
+func (u *Database) DeleteRate(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	return u.S.DeleteRate(ctx, req)
+}
+
+func (u *Database) CreateStayDiscount(ctx context.Context, req *models.StayDiscount) (*models.GeneralResponse, error) {
+	return u.S.CreateStayDiscount(ctx, req)
+}
+
+func (u *Database) DeleteStayDiscount(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	return u.S.DeleteStayDiscount(ctx, req)
+}
+
+func (u *Database) GetRatePlan(ctx context.Context, req *models.RatePlanRequest) (*models.RatePlan, error) {
+	return u.S.GetRatePlan(ctx, req)
+}
+
+func (u *Database) SearchRooms(ctx context.Context, req *models.SearchHotelsRequest) ([]*models.SearchHotel, error) {
+	return u.S.SearchRooms(ctx, req)
+}
+
+func (u *Database) NearbyHotels(ctx context.Context, req *models.NearbyHotelsRequest) ([]*models.NearbyHotel, error) {
+	return u.S.NearbyHotels(ctx, req)
+}
+
+func (u *Database) CreateAmenity(ctx context.Context, req *models.Amenity) (*models.GeneralResponse, error) {
+	return u.S.CreateAmenity(ctx, req)
+}
+
+func (u *Database) ListAmenities(ctx context.Context, req *models.ListAmenitiesRequest) ([]*models.Amenity, error) {
+	return u.S.ListAmenities(ctx, req)
+}
+
+func (u *Database) SetAmenities(ctx context.Context, req *models.SetAmenitiesRequest) (*models.GeneralResponse, error) {
+	return u.S.SetAmenities(ctx, req)
+}
+
+func (u *Database) CreateRoomType(ctx context.Context, req *models.RoomType) (*models.GeneralResponse, error) {
+	return u.S.CreateRoomType(ctx, req)
+}
+
+func (u *Database) GetRoomTypes(ctx context.Context, req *models.GetHotelRequest) ([]*models.RoomType, error) {
+	return u.S.GetRoomTypes(ctx, req)
+}
+
+func (u *Database) GetRoomType(ctx context.Context, req *models.GetRoomTypeRequest) (*models.RoomType, error) {
+	return u.S.GetRoomType(ctx, req)
+}
+
+func (u *Database) UpdateRoomType(ctx context.Context, req *models.RoomType) (*models.GeneralResponse, error) {
+	return u.S.UpdateRoomType(ctx, req)
+}
+
+func (u *Database) DeleteRoomType(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	return u.S.DeleteRoomType(ctx, req)
+}
+
 func (a *Adjust) CreateHotel(ctx context.Context, req *hotel.CreateHotelRequest) (*hotel.GeneralResponse, error) {
 	return a.A.CreateHotel(ctx, req)
 }
@@ -94,3 +162,75 @@ func (a *Adjust) UpdateRooms(ctx context.Context, req *hotel.UpdateRoomRequest)
 func (a *Adjust) DeleteRoom(ctx context.Context, req *hotel.GetroomRequest) (*hotel.GeneralResponse, error) {
 	return a.A.DeleteRoom(ctx, req)
 }
+
+func (a *Adjust) SetCancellationPolicy(ctx context.Context, req *hotel.CancellationPolicy) (*hotel.GeneralResponse, error) {
+	return a.A.SetCancellationPolicy(ctx, req)
+}
+
+func (a *Adjust) GetCancellationPolicy(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.CancellationPolicy, error) {
+	return a.A.GetCancellationPolicy(ctx, req)
+}
+
+func (a *Adjust) CreateRate(ctx context.Context, req *hotel.Rate) (*hotel.GeneralResponse, error) {
+	return a.A.CreateRate(ctx, req)
+}
+
+func (a *Adjust) DeleteRate(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	return a.A.DeleteRate(ctx, req)
+}
+
+func (a *Adjust) CreateStayDiscount(ctx context.Context, req *hotel.StayDiscount) (*hotel.GeneralResponse, error) {
+	return a.A.CreateStayDiscount(ctx, req)
+}
+
+func (a *Adjust) DeleteStayDiscount(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	return a.A.DeleteStayDiscount(ctx, req)
+}
+
+func (a *Adjust) GetRatePlan(ctx context.Context, req *hotel.RatePlanRequest) (*hotel.RatePlan, error) {
+	return a.A.GetRatePlan(ctx, req)
+}
+
+func (a *Adjust) Quote(ctx context.Context, req *hotel.QuoteRequest) (*hotel.QuoteResponse, error) {
+	return a.A.Quote(ctx, req)
+}
+
+func (a *Adjust) SearchHotels(ctx context.Context, req *hotel.SearchHotelsRequest) (*hotel.SearchHotelsResponse, error) {
+	return a.A.SearchHotels(ctx, req)
+}
+
+func (a *Adjust) NearbyHotels(ctx context.Context, req *hotel.NearbyHotelsRequest) (*hotel.NearbyHotelsResponse, error) {
+	return a.A.NearbyHotels(ctx, req)
+}
+
+func (a *Adjust) CreateAmenity(ctx context.Context, req *hotel.Amenity) (*hotel.GeneralResponse, error) {
+	return a.A.CreateAmenity(ctx, req)
+}
+
+func (a *Adjust) ListAmenities(ctx context.Context, req *hotel.ListAmenitiesRequest) (*hotel.ListAmenitiesResponse, error) {
+	return a.A.ListAmenities(ctx, req)
+}
+
+func (a *Adjust) SetHotelAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	return a.A.SetHotelAmenities(ctx, req)
+}
+
+func (a *Adjust) SetRoomAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	return a.A.SetRoomAmenities(ctx, req)
+}
+
+func (a *Adjust) CreateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	return a.A.CreateRoomType(ctx, req)
+}
+
+func (a *Adjust) GetRoomTypes(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.RoomTypesResponse, error) {
+	return a.A.GetRoomTypes(ctx, req)
+}
+
+func (a *Adjust) UpdateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	return a.A.UpdateRoomType(ctx, req)
+}
+
+func (a *Adjust) DeleteRoomType(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	return a.A.DeleteRoomType(ctx, req)
+}
@@ -3,10 +3,23 @@ package adjustservice
 import (
 	"context"
 	"errors"
+	"fmt"
 	"hotel-service/internal/interface/services"
+	"hotel-service/internal/service/pricing"
 	"hotel-service/models"
 	"hotel-service/pkg/proto/hotel"
 	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxStayNights bounds the stays Quote prices night by night.
+	maxStayNights = 365
+	// defaultCapacity is given to room types created without a capacity.
+	defaultCapacity = 2
 )
 
 type Adjust struct {
@@ -15,11 +28,16 @@ type Adjust struct {
 
 func (u *Adjust) CreateHotel(ctx context.Context, req *hotel.CreateHotelRequest) (*hotel.GeneralResponse, error) {
 	if req.Address != "" && req.Location != "" && req.Name != "" && req.Rating != 0 {
+		if err := checkCoordinates(req.Latitude, req.Longitude); err != nil {
+			return nil, err
+		}
 		var newreq = models.CreateHotelRequest{
-			Name:     req.Name,
-			Location: req.Location,
-			Rating:   req.Rating,
-			Address:  req.Address,
+			Name:      req.Name,
+			Location:  req.Location,
+			Rating:    req.Rating,
+			Address:   req.Address,
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
 		}
 		res, err := u.S.CreateHotel(ctx, &newreq)
 		if err != nil {
@@ -39,16 +57,9 @@ func (u *Adjust) GetHotel(ctx context.Context, req *hotel.GetHotelRequest) (*hot
 	}
 	var rooms []*hotel.UpdateRoomRequest
 	for _, v := range res.Rooms {
-		var all = hotel.UpdateRoomRequest{
-			Available:     v.Available,
-			HotelId:       v.HotelID,
-			RoomType:      v.RoomType,
-			Id:            v.ID,
-			PricePerNight: v.PricePerNight,
-		}
-		rooms = append(rooms, &all)
+		rooms = append(rooms, roomResponse(v))
 	}
-	return &hotel.GetHotelResponse{Id: res.ID, Name: res.Name, Location: res.Location, Rating: res.Rating, Address: res.Address, Rooms: rooms}, nil
+	return &hotel.GetHotelResponse{Id: res.ID, Name: res.Name, Location: res.Location, Rating: res.Rating, Address: res.Address, Rooms: rooms, Latitude: res.Latitude, Longitude: res.Longitude, Amenities: res.Amenities}, nil
 }
 
 func (u *Adjust) Gets(ctx context.Context, req *hotel.GetsRequest) (*hotel.GetsResponse, error) {
@@ -61,11 +72,13 @@ func (u *Adjust) Gets(ctx context.Context, req *hotel.GetsRequest) (*hotel.GetsR
 
 	for _, v := range res {
 		var all = hotel.UpdateHotelRequest{
-			Id:       v.ID,
-			Name:     v.Name,
-			Location: v.Location,
-			Rating:   v.Rating,
-			Address:  v.Address,
+			Id:        v.ID,
+			Name:      v.Name,
+			Location:  v.Location,
+			Rating:    v.Rating,
+			Address:   v.Address,
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
 		}
 		hotels = append(hotels, &all)
 	}
@@ -73,12 +86,17 @@ func (u *Adjust) Gets(ctx context.Context, req *hotel.GetsRequest) (*hotel.GetsR
 }
 
 func (u *Adjust) UpdateHotel(ctx context.Context, req *hotel.UpdateHotelRequest) (*hotel.GeneralResponse, error) {
+		if err := checkCoordinates(req.Latitude, req.Longitude); err != nil {
+			return nil, err
+		}
 		var newreq = models.UpdateHotelRequest{
-			ID:       req.Id,
-			Name:     req.Name,
-			Location: req.Location,
-			Rating:   req.Rating,
-			Address:  req.Address,
+			ID:        req.Id,
+			Name:      req.Name,
+			Location:  req.Location,
+			Rating:    req.Rating,
+			Address:   req.Address,
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
 		}
 		res, err := u.S.UpdateHotel(ctx, &newreq)
 		if err != nil {
@@ -97,12 +115,23 @@ func (u *Adjust) DeleteHotel(ctx context.Context, req *hotel.GetHotelRequest) (*
 	return &hotel.GeneralResponse{Message: res.Message}, nil
 }
 
+// CreateRoom добавляет физический номер существующего типа: тип задаётся
+// по id или по названию, цена и вместимость берутся из типа
 func (u *Adjust) CreateRoom(ctx context.Context, req *hotel.CreateRoomRequest) (*hotel.GeneralResponse, error) {
-	if req.HotelId!=0 && req.RoomType!="" && req.PricePerNight!=0{
+	if req.HotelId!=0 && (req.RoomType!="" || req.RoomTypeId!=0){
+		if strings.TrimSpace(req.RoomNumber) == "" {
+			return nil, fmt.Errorf("%w: room number is required", models.ErrInvalidRoom)
+		}
+		roomType, err := u.S.GetRoomType(ctx, &models.GetRoomTypeRequest{HotelID: req.HotelId, ID: req.RoomTypeId, Name: req.RoomType})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
 		var newrew = models.CreateRoomRequest{
-			HotelID:       req.HotelId,
-			RoomType:      req.RoomType,
-			PricePerNight: req.PricePerNight,
+			HotelID:    req.HotelId,
+			RoomTypeID: roomType.ID,
+			RoomNumber: strings.TrimSpace(req.RoomNumber),
+			Floor:      req.Floor,
 		}
 		res, err := u.S.CreateRoom(ctx, &newrew)
 		if err != nil {
@@ -120,7 +149,7 @@ func (u *Adjust) GetRoom(ctx context.Context, req *hotel.GetroomRequest) (*hotel
 		log.Println(err)
 		return nil, err
 	}
-	return &hotel.UpdateRoomRequest{HotelId: res.HotelID, Id: res.ID, RoomType: res.RoomType, PricePerNight: res.PricePerNight, Available: res.Available}, nil
+	return roomResponse(res), nil
 }
 
 func (u *Adjust) GetRooms(ctx context.Context, req *hotel.GetroomRequest) (*hotel.GetroomResponse, error) {
@@ -132,24 +161,47 @@ func (u *Adjust) GetRooms(ctx context.Context, req *hotel.GetroomRequest) (*hote
 	var rooms []*hotel.UpdateRoomRequest
 
 	for _, v := range res.Rooms {
-		var all = hotel.UpdateRoomRequest{
-			HotelId:       v.HotelID,
-			Id:            v.ID,
-			RoomType:      v.RoomType,
-			PricePerNight: v.PricePerNight,
-			Available:     v.Available,
-		}
-		rooms = append(rooms, &all)
+		rooms = append(rooms, roomResponse(v))
 	}
 	return &hotel.GetroomResponse{Rooms: rooms}, nil
 }
+
+// roomResponse переводит номер вместе с полями его типа в ответ gRPC
+func roomResponse(v *models.UpdateRoomRequest) *hotel.UpdateRoomRequest {
+	return &hotel.UpdateRoomRequest{
+		HotelId:       v.HotelID,
+		Id:            v.ID,
+		RoomType:      v.RoomType,
+		PricePerNight: v.PricePerNight,
+		Available:     v.Available,
+		Capacity:      v.Capacity,
+		Amenities:     v.Amenities,
+		RoomTypeId:    v.RoomTypeID,
+		RoomNumber:    v.RoomNumber,
+		Floor:         v.Floor,
+	}
+}
+// UpdateRooms меняет доступность, номер, этаж или тип номера. Цена и
+// вместимость принадлежат типу и меняются через UpdateRoomType
 func (u *Adjust) UpdateRooms(ctx context.Context, req *hotel.UpdateRoomRequest) (*hotel.GeneralResponse, error) {
+	if req.PricePerNight != 0 || req.Capacity != 0 {
+		return nil, fmt.Errorf("%w: price and capacity are set on the room type", models.ErrInvalidRoom)
+	}
 	var newreq = models.UpdateRoomRequest{
-		HotelID:       req.HotelId,
-		ID:            req.Id,
-		RoomType:      req.RoomType,
-		PricePerNight: req.PricePerNight,
-		Available:     req.Available,
+		HotelID:    req.HotelId,
+		ID:         req.Id,
+		Available:  req.Available,
+		RoomNumber: strings.TrimSpace(req.RoomNumber),
+		Floor:      req.Floor,
+	}
+	// Тип проверяется внутри отеля: внешний ключ не мешает взять тип чужого отеля
+	if req.RoomTypeId != 0 || req.RoomType != "" {
+		roomType, err := u.S.GetRoomType(ctx, &models.GetRoomTypeRequest{HotelID: req.HotelId, ID: req.RoomTypeId, Name: req.RoomType})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		newreq.RoomTypeID = roomType.ID
 	}
 	res, err := u.S.UpdateRooms(ctx, &newreq)
 	if err != nil {
@@ -167,3 +219,194 @@ func (u *Adjust) DeleteRoom(ctx context.Context, req *hotel.GetroomRequest) (*ho
 	}
 	return &hotel.GeneralResponse{Message: res.Message}, nil
 }
+
+func (u *Adjust) SetCancellationPolicy(ctx context.Context, req *hotel.CancellationPolicy) (*hotel.GeneralResponse, error) {
+	if req.HotelId == 0 {
+		return nil, errors.New("missing field")
+	}
+	if req.FreeCancellationHours < 0 || req.PenaltyNights < 0 {
+		return nil, errors.New("free_cancellation_hours and penalty_nights cannot be negative")
+	}
+	if _, err := u.S.GetHotel(ctx, &models.GetHotelRequest{ID: req.HotelId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res, err := u.S.SetCancellationPolicy(ctx, &models.CancellationPolicy{
+		HotelID:               req.HotelId,
+		FreeCancellationHours: req.FreeCancellationHours,
+		PenaltyNights:         req.PenaltyNights,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) GetCancellationPolicy(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.CancellationPolicy, error) {
+	res, err := u.S.GetCancellationPolicy(ctx, &models.GetHotelRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.CancellationPolicy{HotelId: res.HotelID, FreeCancellationHours: res.FreeCancellationHours, PenaltyNights: res.PenaltyNights}, nil
+}
+
+func (u *Adjust) CreateRate(ctx context.Context, req *hotel.Rate) (*hotel.GeneralResponse, error) {
+	if req.HotelId == 0 || req.StartDate == nil || req.EndDate == nil || req.WeekdayPrice <= 0 {
+		return nil, errors.New("missing field")
+	}
+	if !req.EndDate.AsTime().After(req.StartDate.AsTime()) {
+		return nil, errors.New("end_date must be after start_date")
+	}
+	if req.WeekendPrice < 0 {
+		return nil, errors.New("weekend_price cannot be negative")
+	}
+	if _, err := u.S.GetHotel(ctx, &models.GetHotelRequest{ID: req.HotelId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	// Без отдельной цены на выходные действует будничная
+	weekend := req.WeekendPrice
+	if weekend == 0 {
+		weekend = req.WeekdayPrice
+	}
+	res, err := u.S.CreateRate(ctx, &models.Rate{
+		HotelID:      req.HotelId,
+		RoomType:     req.RoomType,
+		StartDate:    day(req.StartDate.AsTime()),
+		EndDate:      day(req.EndDate.AsTime()),
+		WeekdayPrice: req.WeekdayPrice,
+		WeekendPrice: weekend,
+		Priority:     req.Priority,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) DeleteRate(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.S.DeleteRate(ctx, &models.DeleteRateRequest{HotelID: req.HotelId, ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) CreateStayDiscount(ctx context.Context, req *hotel.StayDiscount) (*hotel.GeneralResponse, error) {
+	if req.HotelId == 0 || req.MinNights < 1 {
+		return nil, errors.New("missing field")
+	}
+	if req.Percent <= 0 || req.Percent >= 100 {
+		return nil, errors.New("percent must be between 0 and 100")
+	}
+	if _, err := u.S.GetHotel(ctx, &models.GetHotelRequest{ID: req.HotelId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res, err := u.S.CreateStayDiscount(ctx, &models.StayDiscount{
+		HotelID:   req.HotelId,
+		RoomType:  req.RoomType,
+		MinNights: req.MinNights,
+		Percent:   req.Percent,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) DeleteStayDiscount(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.S.DeleteStayDiscount(ctx, &models.DeleteRateRequest{HotelID: req.HotelId, ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) GetRatePlan(ctx context.Context, req *hotel.RatePlanRequest) (*hotel.RatePlan, error) {
+	res, err := u.S.GetRatePlan(ctx, &models.RatePlanRequest{HotelID: req.HotelId, RoomType: req.RoomType})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var plan hotel.RatePlan
+	for _, v := range res.Rates {
+		plan.Rates = append(plan.Rates, &hotel.Rate{
+			Id:           v.ID,
+			HotelId:      v.HotelID,
+			RoomType:     v.RoomType,
+			StartDate:    timestamppb.New(v.StartDate),
+			EndDate:      timestamppb.New(v.EndDate),
+			WeekdayPrice: v.WeekdayPrice,
+			WeekendPrice: v.WeekendPrice,
+			Priority:     v.Priority,
+		})
+	}
+	for _, v := range res.Discounts {
+		plan.Discounts = append(plan.Discounts, &hotel.StayDiscount{
+			Id:        v.ID,
+			HotelId:   v.HotelID,
+			RoomType:  v.RoomType,
+			MinNights: v.MinNights,
+			Percent:   v.Percent,
+		})
+	}
+	return &plan, nil
+}
+
+// Quote считает цену проживания в номере по ночам с учётом тарифов и скидок.
+// Без room_id цена считается по типу номера room_type, номер ещё не выбран
+func (u *Adjust) Quote(ctx context.Context, req *hotel.QuoteRequest) (*hotel.QuoteResponse, error) {
+	if req.HotelId == 0 || (req.RoomId == 0 && req.RoomType == "") || req.CheckInDate == nil || req.CheckOutDate == nil {
+		return nil, errors.New("missing field")
+	}
+	checkIn, checkOut := day(req.CheckInDate.AsTime()), day(req.CheckOutDate.AsTime())
+	if !checkOut.After(checkIn) {
+		return nil, errors.New("check-out date must be after check-in date")
+	}
+	if checkOut.Sub(checkIn) > maxStayNights*24*time.Hour {
+		return nil, errors.New("stay is too long to be priced")
+	}
+
+	var roomID int32
+	var roomType string
+	var basePrice float32
+	if req.RoomId != 0 {
+		room, err := u.S.GetRoom(ctx, &models.GetRoomRequest{HotelID: req.HotelId, ID: req.RoomId})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		roomID, roomType, basePrice = room.ID, room.RoomType, room.PricePerNight
+	} else {
+		t, err := u.S.GetRoomType(ctx, &models.GetRoomTypeRequest{HotelID: req.HotelId, Name: req.RoomType})
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		roomType, basePrice = t.Name, t.BasePrice
+	}
+	plan, err := u.S.GetRatePlan(ctx, &models.RatePlanRequest{HotelID: req.HotelId, RoomType: roomType, From: checkIn, To: checkOut})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	quote := pricing.Quote(basePrice, plan.Rates, plan.Discounts, checkIn, checkOut)
+	res := &hotel.QuoteResponse{RoomId: roomID, Subtotal: quote.Subtotal, Discount: quote.Discount, Total: quote.Total}
+	for _, v := range quote.Nights {
+		res.Nights = append(res.Nights, &hotel.NightPrice{Date: timestamppb.New(v.Date), Price: v.Price})
+	}
+	return res, nil
+}
+
+// day отбрасывает время, тарифы и брони считаются по датам в UTC
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
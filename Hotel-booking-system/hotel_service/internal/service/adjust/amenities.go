@@ -0,0 +1,106 @@
+package adjustservice
+
+import (
+	"context"
+	"fmt"
+	"hotel-service/models"
+	"hotel-service/pkg/proto/hotel"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// amenityCode — допустимый код удобства: wifi, pets_allowed
+var amenityCode = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+// CreateAmenity добавляет удобство в каталог
+func (u *Adjust) CreateAmenity(ctx context.Context, req *hotel.Amenity) (*hotel.GeneralResponse, error) {
+	code := strings.ToLower(strings.TrimSpace(req.Code))
+	if !amenityCode.MatchString(code) {
+		return nil, fmt.Errorf("%w: code must be 1 to 50 lowercase letters, digits or underscores", models.ErrInvalidAmenity)
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", models.ErrInvalidAmenity)
+	}
+	if err := checkScope(req.Scope, false); err != nil {
+		return nil, err
+	}
+	res, err := u.S.CreateAmenity(ctx, &models.Amenity{Code: code, Name: strings.TrimSpace(req.Name), Scope: req.Scope})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) ListAmenities(ctx context.Context, req *hotel.ListAmenitiesRequest) (*hotel.ListAmenitiesResponse, error) {
+	if err := checkScope(req.Scope, true); err != nil {
+		return nil, err
+	}
+	res, err := u.S.ListAmenities(ctx, &models.ListAmenitiesRequest{Scope: req.Scope})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var amenities []*hotel.Amenity
+	for _, v := range res {
+		amenities = append(amenities, &hotel.Amenity{Id: v.ID, Code: v.Code, Name: v.Name, Scope: v.Scope})
+	}
+	return &hotel.ListAmenitiesResponse{Amenities: amenities}, nil
+}
+
+// SetHotelAmenities заменяет удобства отеля переданным списком,
+// пустой список убирает все удобства
+func (u *Adjust) SetHotelAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	if _, err := u.S.GetHotel(ctx, &models.GetHotelRequest{ID: req.HotelId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res, err := u.S.SetAmenities(ctx, &models.SetAmenitiesRequest{HotelID: req.HotelId, Amenities: amenityCodes(req.Amenities)})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+// SetRoomAmenities заменяет удобства номера отеля переданным списком
+func (u *Adjust) SetRoomAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	if req.RoomId == 0 {
+		return nil, fmt.Errorf("%w: room id is required", models.ErrInvalidAmenity)
+	}
+	// Номер должен принадлежать отелю из запроса
+	if _, err := u.S.GetRoom(ctx, &models.GetRoomRequest{HotelID: req.HotelId, ID: req.RoomId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res, err := u.S.SetAmenities(ctx, &models.SetAmenitiesRequest{HotelID: req.HotelId, RoomID: req.RoomId, Amenities: amenityCodes(req.Amenities)})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+// checkScope проверяет область удобства; пустая допускается, если empty
+func checkScope(scope string, empty bool) error {
+	if (empty && scope == "") || scope == models.AmenityScopeHotel || scope == models.AmenityScopeRoom {
+		return nil
+	}
+	return fmt.Errorf("%w: scope must be %q or %q", models.ErrInvalidAmenity, models.AmenityScopeHotel, models.AmenityScopeRoom)
+}
+
+// amenityCodes приводит коды к нижнему регистру и убирает пустые и повторяющиеся
+func amenityCodes(codes []string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, v := range codes {
+		code := strings.ToLower(strings.TrimSpace(v))
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		res = append(res, code)
+	}
+	return res
+}
@@ -0,0 +1,77 @@
+package adjustservice
+
+import (
+	"context"
+	"fmt"
+	"hotel-service/models"
+	"hotel-service/pkg/proto/hotel"
+	"log"
+)
+
+const (
+	// maxRadiusKm ограничивает радиус поиска отелей рядом с точкой
+	maxRadiusKm        = 500
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+)
+
+// NearbyHotels возвращает отели в радиусе RadiusKm от точки, сначала ближайшие.
+// Отели без координат в поиск не попадают.
+func (u *Adjust) NearbyHotels(ctx context.Context, req *hotel.NearbyHotelsRequest) (*hotel.NearbyHotelsResponse, error) {
+	if err := checkCoordinates(&req.Latitude, &req.Longitude); err != nil {
+		return nil, err
+	}
+	if req.RadiusKm <= 0 || req.RadiusKm > maxRadiusKm {
+		return nil, fmt.Errorf("%w: radius must be greater than 0 and at most %v km", models.ErrInvalidSearch, maxRadiusKm)
+	}
+	if req.Limit < 1 {
+		req.Limit = defaultNearbyLimit
+	}
+	if req.Limit > maxNearbyLimit {
+		req.Limit = maxNearbyLimit
+	}
+
+	found, err := u.S.NearbyHotels(ctx, &models.NearbyHotelsRequest{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		RadiusKm:  req.RadiusKm,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	res := &hotel.NearbyHotelsResponse{}
+	for _, h := range found {
+		res.Hotels = append(res.Hotels, &hotel.NearbyHotel{
+			Id:         h.ID,
+			Name:       h.Name,
+			Location:   h.Location,
+			Rating:     h.Rating,
+			Address:    h.Address,
+			Latitude:   h.Latitude,
+			Longitude:  h.Longitude,
+			DistanceKm: h.DistanceKm,
+		})
+	}
+	return res, nil
+}
+
+// checkCoordinates проверяет, что координаты заданы парой и лежат в допустимых пределах.
+// Если не заданы обе, координаты отеля не меняются.
+func checkCoordinates(latitude, longitude *float64) error {
+	if latitude == nil && longitude == nil {
+		return nil
+	}
+	if latitude == nil || longitude == nil {
+		return fmt.Errorf("%w: latitude and longitude must be set together", models.ErrInvalidSearch)
+	}
+	if *latitude < -90 || *latitude > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", models.ErrInvalidSearch)
+	}
+	if *longitude < -180 || *longitude > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", models.ErrInvalidSearch)
+	}
+	return nil
+}
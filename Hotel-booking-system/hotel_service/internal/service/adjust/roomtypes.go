@@ -0,0 +1,103 @@
+package adjustservice
+
+import (
+	"context"
+	"fmt"
+	"hotel-service/models"
+	"hotel-service/pkg/proto/hotel"
+	"log"
+	"strings"
+)
+
+// CreateRoomType добавляет тип номера отеля. Название должно быть уникальным
+// в отеле, по нему тарифы, скидки и брони находят тип
+func (u *Adjust) CreateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if req.HotelId == 0 || name == "" {
+		return nil, fmt.Errorf("%w: hotel_id and name are required", models.ErrInvalidRoomType)
+	}
+	if req.Capacity < 0 || req.BasePrice <= 0 {
+		return nil, fmt.Errorf("%w: base price must be positive and capacity not negative", models.ErrInvalidRoomType)
+	}
+	if _, err := u.S.GetHotel(ctx, &models.GetHotelRequest{ID: req.HotelId}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	roomType := models.RoomType{
+		HotelID:          req.HotelId,
+		Name:             name,
+		Capacity:         req.Capacity,
+		BedConfiguration: strings.TrimSpace(req.BedConfiguration),
+		BasePrice:        req.BasePrice,
+		Description:      strings.TrimSpace(req.Description),
+	}
+	if roomType.Capacity == 0 {
+		roomType.Capacity = defaultCapacity
+	}
+	res, err := u.S.CreateRoomType(ctx, &roomType)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+func (u *Adjust) GetRoomTypes(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.RoomTypesResponse, error) {
+	res, err := u.S.GetRoomTypes(ctx, &models.GetHotelRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var roomTypes []*hotel.RoomType
+	for _, v := range res {
+		roomTypes = append(roomTypes, &hotel.RoomType{
+			Id:               v.ID,
+			HotelId:          v.HotelID,
+			Name:             v.Name,
+			Capacity:         v.Capacity,
+			BedConfiguration: v.BedConfiguration,
+			BasePrice:        v.BasePrice,
+			Description:      v.Description,
+		})
+	}
+	return &hotel.RoomTypesResponse{RoomTypes: roomTypes}, nil
+}
+
+// UpdateRoomType меняет вместимость, кровати, базовую цену и описание типа.
+// Переименовать тип нельзя: тарифы и брони ссылаются на него по названию
+func (u *Adjust) UpdateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	if req.Capacity < 0 || req.BasePrice < 0 {
+		return nil, fmt.Errorf("%w: base price and capacity must not be negative", models.ErrInvalidRoomType)
+	}
+	current, err := u.S.GetRoomType(ctx, &models.GetRoomTypeRequest{HotelID: req.HotelId, ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if name := strings.TrimSpace(req.Name); name != "" && name != current.Name {
+		return nil, fmt.Errorf("%w: room type %q cannot be renamed", models.ErrInvalidRoomType, current.Name)
+	}
+	res, err := u.S.UpdateRoomType(ctx, &models.RoomType{
+		ID:               req.Id,
+		HotelID:          req.HotelId,
+		Capacity:         req.Capacity,
+		BedConfiguration: strings.TrimSpace(req.BedConfiguration),
+		BasePrice:        req.BasePrice,
+		Description:      strings.TrimSpace(req.Description),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
+
+// DeleteRoomType удаляет тип без номеров, номера сначала переводятся на другой тип
+func (u *Adjust) DeleteRoomType(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.S.DeleteRoomType(ctx, &models.DeleteRateRequest{HotelID: req.HotelId, ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &hotel.GeneralResponse{Message: res.Message}, nil
+}
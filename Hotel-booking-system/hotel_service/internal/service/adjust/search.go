@@ -0,0 +1,86 @@
+package adjustservice
+
+import (
+	"context"
+	"errors"
+	"hotel-service/internal/service/pricing"
+	"hotel-service/models"
+	"hotel-service/pkg/proto/hotel"
+	"log"
+	"time"
+)
+
+// SearchHotels возвращает отели с номерами, подходящими под фильтры, и цену
+// каждого номера за весь период. Занятость номеров здесь не учитывается,
+// её проверяет сервис бронирования.
+func (u *Adjust) SearchHotels(ctx context.Context, req *hotel.SearchHotelsRequest) (*hotel.SearchHotelsResponse, error) {
+	if req.CheckInDate == nil || req.CheckOutDate == nil {
+		return nil, errors.New("missing field")
+	}
+	checkIn, checkOut := day(req.CheckInDate.AsTime()), day(req.CheckOutDate.AsTime())
+	if !checkOut.After(checkIn) {
+		return nil, errors.New("check-out date must be after check-in date")
+	}
+	if checkOut.Sub(checkIn) > maxStayNights*24*time.Hour {
+		return nil, errors.New("stay is too long to be priced")
+	}
+	nights := float32(checkOut.Sub(checkIn) / (24 * time.Hour))
+
+	candidates, err := u.S.SearchRooms(ctx, &models.SearchHotelsRequest{
+		Location:  req.Location,
+		RoomType:  req.RoomType,
+		Guests:    req.Guests,
+		MinRating: req.MinRating,
+		Amenities: amenityCodes(req.Amenities),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	res := &hotel.SearchHotelsResponse{}
+	for _, h := range candidates {
+		// Тарифы одинаковы для всех номеров одного типа
+		plans := make(map[string]*models.RatePlan)
+		var rooms []*hotel.SearchRoom
+		for _, room := range h.Rooms {
+			plan, ok := plans[room.RoomType]
+			if !ok {
+				plan, err = u.S.GetRatePlan(ctx, &models.RatePlanRequest{HotelID: h.ID, RoomType: room.RoomType, From: checkIn, To: checkOut})
+				if err != nil {
+					log.Println(err)
+					return nil, err
+				}
+				plans[room.RoomType] = plan
+			}
+
+			quote := pricing.Quote(room.PricePerNight, plan.Rates, plan.Discounts, checkIn, checkOut)
+			nightly := quote.Total / nights
+			if (req.MinPrice != 0 && nightly < req.MinPrice) || (req.MaxPrice != 0 && nightly > req.MaxPrice) {
+				continue
+			}
+			rooms = append(rooms, &hotel.SearchRoom{
+				Id:           room.ID,
+				HotelId:      room.HotelID,
+				RoomType:     room.RoomType,
+				Capacity:     room.Capacity,
+				NightlyPrice: nightly,
+				Total:        quote.Total,
+				Amenities:    room.Amenities,
+			})
+		}
+		if len(rooms) == 0 {
+			continue
+		}
+		res.Hotels = append(res.Hotels, &hotel.SearchHotel{
+			Id:        h.ID,
+			Name:      h.Name,
+			Location:  h.Location,
+			Rating:    h.Rating,
+			Address:   h.Address,
+			Rooms:     rooms,
+			Amenities: h.Amenities,
+		})
+	}
+	return res, nil
+}
@@ -2,9 +2,14 @@ package grpcmethod
 
 import (
 	"context"
+	"errors"
 	"hotel-service/internal/interface/services"
+	"hotel-service/models"
 	"hotel-service/pkg/proto/hotel"
 	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GrpcService struct {
@@ -21,10 +26,14 @@ func (u *GrpcService) CreateHotel(ctx context.Context, req *hotel.CreateHotelReq
 	return res, nil
 }
 
+// CreateRoom answers InvalidArgument for an unknown room type or a taken room number.
 func (u *GrpcService) CreateRoom(ctx context.Context, req *hotel.CreateRoomRequest) (*hotel.GeneralResponse, error) {
 	res, err := u.A.CreateRoom(ctx, req)
 	if err != nil {
 		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoom) || errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	return res, nil
@@ -85,10 +94,205 @@ func (u *GrpcService) Update(ctx context.Context, req *hotel.UpdateHotelRequest)
 	}
 	return res, nil
 }
+
+// UpdateRoom answers InvalidArgument for an unknown room type or a taken room number.
 func (u *GrpcService) UpdateRoom(ctx context.Context, req *hotel.UpdateRoomRequest) (*hotel.GeneralResponse, error) {
 	res, err := u.A.UpdateRooms(ctx, req)
 	if err != nil {
 		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoom) || errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) SetCancellationPolicy(ctx context.Context, req *hotel.CancellationPolicy) (*hotel.GeneralResponse, error) {
+	res, err := u.A.SetCancellationPolicy(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) GetCancellationPolicy(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.CancellationPolicy, error) {
+	res, err := u.A.GetCancellationPolicy(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) CreateRate(ctx context.Context, req *hotel.Rate) (*hotel.GeneralResponse, error) {
+	res, err := u.A.CreateRate(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) DeleteRate(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.A.DeleteRate(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) CreateStayDiscount(ctx context.Context, req *hotel.StayDiscount) (*hotel.GeneralResponse, error) {
+	res, err := u.A.CreateStayDiscount(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) DeleteStayDiscount(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.A.DeleteStayDiscount(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) GetRatePlan(ctx context.Context, req *hotel.RatePlanRequest) (*hotel.RatePlan, error) {
+	res, err := u.A.GetRatePlan(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// Quote answers InvalidArgument for an unknown room type.
+func (u *GrpcService) Quote(ctx context.Context, req *hotel.QuoteRequest) (*hotel.QuoteResponse, error) {
+	res, err := u.A.Quote(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+func (u *GrpcService) SearchHotels(ctx context.Context, req *hotel.SearchHotelsRequest) (*hotel.SearchHotelsResponse, error) {
+	res, err := u.A.SearchHotels(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// NearbyHotels answers InvalidArgument for coordinates or a radius out of range.
+func (u *GrpcService) NearbyHotels(ctx context.Context, req *hotel.NearbyHotelsRequest) (*hotel.NearbyHotelsResponse, error) {
+	res, err := u.A.NearbyHotels(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidSearch) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateAmenity answers InvalidArgument for malformed or unknown amenities.
+func (u *GrpcService) CreateAmenity(ctx context.Context, req *hotel.Amenity) (*hotel.GeneralResponse, error) {
+	res, err := u.A.CreateAmenity(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListAmenities answers InvalidArgument for malformed or unknown amenities.
+func (u *GrpcService) ListAmenities(ctx context.Context, req *hotel.ListAmenitiesRequest) (*hotel.ListAmenitiesResponse, error) {
+	res, err := u.A.ListAmenities(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetHotelAmenities answers InvalidArgument for malformed or unknown amenities.
+func (u *GrpcService) SetHotelAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.A.SetHotelAmenities(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetRoomAmenities answers InvalidArgument for malformed or unknown amenities.
+func (u *GrpcService) SetRoomAmenities(ctx context.Context, req *hotel.SetAmenitiesRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.A.SetRoomAmenities(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidAmenity) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateRoomType answers InvalidArgument for a room type name already taken in the hotel.
+func (u *GrpcService) CreateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	res, err := u.A.CreateRoomType(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *GrpcService) GetRoomTypes(ctx context.Context, req *hotel.GetHotelRequest) (*hotel.RoomTypesResponse, error) {
+	res, err := u.A.GetRoomTypes(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateRoomType answers InvalidArgument for an unknown room type or a renaming attempt.
+func (u *GrpcService) UpdateRoomType(ctx context.Context, req *hotel.RoomType) (*hotel.GeneralResponse, error) {
+	res, err := u.A.UpdateRoomType(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteRoomType answers InvalidArgument for a room type that still has rooms.
+func (u *GrpcService) DeleteRoomType(ctx context.Context, req *hotel.DeleteRateRequest) (*hotel.GeneralResponse, error) {
+	res, err := u.A.DeleteRoomType(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrInvalidRoomType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	return res, nil
@@ -0,0 +1,58 @@
+// Package pricing prices a stay night by night from a room's base price,
+// the hotel's rates and its length-of-stay discounts.
+package pricing
+
+import (
+	"hotel-service/models"
+	"math"
+	"time"
+)
+
+// Quote prices every night of [checkIn, checkOut).
+//
+// A night costs the price of the first rate in rates that covers it, so rates
+// must be ordered by precedence as sqlbuilder.GetRates returns them. Friday and
+// Saturday nights use the rate's weekend price. Nights no rate covers cost base.
+// The biggest discount whose MinNights the stay reaches is taken off the subtotal.
+func Quote(base float32, rates []*models.Rate, discounts []*models.StayDiscount, checkIn, checkOut time.Time) *models.Quote {
+	var res models.Quote
+	for night := checkIn; night.Before(checkOut); night = night.AddDate(0, 0, 1) {
+		price := nightPrice(base, rates, night)
+		res.Nights = append(res.Nights, &models.NightPrice{Date: night, Price: price})
+		res.Subtotal += price
+	}
+
+	var percent float32
+	for _, v := range discounts {
+		if int(v.MinNights) <= len(res.Nights) && v.Percent > percent {
+			percent = v.Percent
+		}
+	}
+	res.Subtotal = round(res.Subtotal)
+	res.Discount = round(res.Subtotal * percent / 100)
+	res.Total = res.Subtotal - res.Discount
+	return &res
+}
+
+func nightPrice(base float32, rates []*models.Rate, night time.Time) float32 {
+	for _, v := range rates {
+		if night.Before(v.StartDate) || !night.Before(v.EndDate) {
+			continue
+		}
+		if weekend(night) {
+			return v.WeekendPrice
+		}
+		return v.WeekdayPrice
+	}
+	return base
+}
+
+// weekend reports whether the night starting on day is a weekend night.
+func weekend(day time.Time) bool {
+	return day.Weekday() == time.Friday || day.Weekday() == time.Saturday
+}
+
+// round keeps money amounts to whole cents.
+func round(v float32) float32 {
+	return float32(math.Round(float64(v)*100) / 100)
+}
@@ -0,0 +1,126 @@
+package pricing
+
+import (
+	"hotel-service/models"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestQuote(t *testing.T) {
+	// Thursday, so a four night stay covers Friday and Saturday nights.
+	thu := time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return thu.AddDate(0, 0, n) }
+
+	tests := []struct {
+		name      string
+		base      float32
+		rates     []*models.Rate
+		discounts []*models.StayDiscount
+		nights    int
+		prices    []float32
+		discount  float32
+		total     float32
+	}{
+		{
+			name:   "base price without rates",
+			base:   100,
+			nights: 4,
+			prices: []float32{100, 100, 100, 100},
+			total:  400,
+		},
+		{
+			name: "weekend nights use the weekend price",
+			base: 100,
+			rates: []*models.Rate{
+				{StartDate: day(-10), EndDate: day(10), WeekdayPrice: 120, WeekendPrice: 150},
+			},
+			nights: 4,
+			prices: []float32{120, 150, 150, 120},
+			total:  540,
+		},
+		{
+			name: "first matching rate wins",
+			base: 100,
+			rates: []*models.Rate{
+				{StartDate: day(1), EndDate: day(2), WeekdayPrice: 200, WeekendPrice: 200},
+				{StartDate: day(-10), EndDate: day(10), WeekdayPrice: 110, WeekendPrice: 130},
+			},
+			nights: 4,
+			prices: []float32{110, 200, 130, 110},
+			total:  550,
+		},
+		{
+			name: "uncovered nights fall back to base",
+			base: 100,
+			rates: []*models.Rate{
+				{StartDate: day(2), EndDate: day(3), WeekdayPrice: 80, WeekendPrice: 90},
+			},
+			nights: 4,
+			prices: []float32{100, 100, 90, 100},
+			total:  390,
+		},
+		{
+			name: "biggest reached discount is taken",
+			base: 100,
+			discounts: []*models.StayDiscount{
+				{MinNights: 3, Percent: 10},
+				{MinNights: 4, Percent: 15},
+				{MinNights: 5, Percent: 20},
+			},
+			nights:   4,
+			prices:   []float32{100, 100, 100, 100},
+			discount: 60,
+			total:    340,
+		},
+		{
+			name: "discount below its threshold is not taken",
+			base: 100,
+			discounts: []*models.StayDiscount{
+				{MinNights: 4, Percent: 15},
+			},
+			nights: 3,
+			prices: []float32{100, 100, 100},
+			total:  300,
+		},
+		{
+			name: "discount is rounded to cents",
+			base: 10.01,
+			discounts: []*models.StayDiscount{
+				{MinNights: 1, Percent: 7},
+			},
+			nights:   3,
+			prices:   []float32{10.01, 10.01, 10.01},
+			discount: 2.10,
+			total:    27.93,
+		},
+		{
+			name:   "empty stay",
+			base:   100,
+			nights: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Quote(tt.base, tt.rates, tt.discounts, thu, day(tt.nights))
+			if len(res.Nights) != len(tt.prices) {
+				t.Fatalf("got %d nights, want %d", len(res.Nights), len(tt.prices))
+			}
+			for i, v := range res.Nights {
+				if !v.Date.Equal(day(i)) || !near(v.Price, tt.prices[i]) {
+					t.Errorf("night %d = %v %v, want %v %v", i, v.Date, v.Price, day(i), tt.prices[i])
+				}
+			}
+			if !near(res.Discount, tt.discount) || !near(res.Total, tt.total) {
+				t.Errorf("discount, total = %v, %v, want %v, %v", res.Discount, res.Total, tt.discount, tt.total)
+			}
+			if !near(res.Subtotal-res.Discount, res.Total) {
+				t.Errorf("subtotal %v less discount %v is not total %v", res.Subtotal, res.Discount, res.Total)
+			}
+		})
+	}
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 0.001
+}
@@ -1,10 +1,33 @@
 package models
 
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidSearch is returned for coordinates or a radius out of range.
+	ErrInvalidSearch = errors.New("invalid search")
+	// ErrInvalidAmenity is returned for amenity codes that are malformed,
+	// unknown or of the wrong scope.
+	ErrInvalidAmenity = errors.New("invalid amenity")
+	// ErrInvalidRoomType is returned for room types that are unknown,
+	// duplicated or still used by rooms.
+	ErrInvalidRoomType = errors.New("invalid room type")
+	// ErrInvalidRoom is returned for rooms without a number or with a number
+	// already taken in the hotel.
+	ErrInvalidRoom = errors.New("invalid room")
+)
+
 type CreateHotelRequest struct {
 	Name     string `json:"name"`
 	Location string `json:"location"`
 	Rating   int32  `json:"rating"`
 	Address  string `json:"address"`
+	// Latitude and Longitude are in degrees; hotels without them are
+	// left out of nearby searches.
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 
 type GeneralResponse struct {
@@ -15,11 +38,13 @@ type GetsRequest struct {
 }
 
 type UpdateHotelRequest struct {
-	ID       int32  `json:"id"`
-	Name     string `json:"name"`
-	Location string `json:"location"`
-	Rating   int32  `json:"rating"`
-	Address  string `json:"address"`
+	ID        int32    `json:"id"`
+	Name      string   `json:"name"`
+	Location  string   `json:"location"`
+	Rating    int32    `json:"rating"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 
 type GetHotelRequest struct {
@@ -27,18 +52,25 @@ type GetHotelRequest struct {
 }
 
 type GetHotelResponse struct {
-	ID       int32                `json:"id"`
-	Name     string               `json:"name"`
-	Location string               `json:"location"`
-	Rating   int32                `json:"rating"`
-	Address  string               `json:"address"`
-	Rooms    []*UpdateRoomRequest `json:"rooms"`
+	ID        int32                `json:"id"`
+	Name      string               `json:"name"`
+	Location  string               `json:"location"`
+	Rating    int32                `json:"rating"`
+	Address   string               `json:"address"`
+	Rooms     []*UpdateRoomRequest `json:"rooms"`
+	Latitude  *float64             `json:"latitude"`
+	Longitude *float64             `json:"longitude"`
+	Amenities []string             `json:"amenities"`
 }
 
+// CreateRoomRequest adds a physical room of an existing room type, given
+// by RoomTypeID or by its name in RoomType.
 type CreateRoomRequest struct {
-	HotelID       int32   `json:"hotel_id"`
-	RoomType      string  `json:"room_type"`
-	PricePerNight float32 `json:"price_per_night"`
+	HotelID    int32  `json:"hotel_id"`
+	RoomType   string `json:"room_type"`
+	RoomTypeID int32  `json:"room_type_id"`
+	RoomNumber string `json:"room_number"`
+	Floor      int32  `json:"floor"`
 }
 
 type GetRoomRequest struct {
@@ -57,4 +89,182 @@ type UpdateRoomRequest struct {
 	PricePerNight float32 `json:"price_per_night"`
 	ID            int32   `json:"id"`
 	HotelID       int32   `json:"hotel_id"`
+	// Capacity is how many guests the room sleeps.
+	Capacity int32 `json:"capacity"`
+	// Amenities is filled when reading rooms; updates change it through SetRoomAmenities.
+	Amenities []string `json:"amenities"`
+	// RoomType, PricePerNight and Capacity come from the room type; updates
+	// move the room to another type through RoomTypeID.
+	RoomTypeID int32  `json:"room_type_id"`
+	RoomNumber string `json:"room_number"`
+	// Floor is nil when an update leaves it unchanged.
+	Floor *int32 `json:"floor"`
+}
+
+// CancellationPolicy describes how long before check-in a guest may cancel
+// for free and how many nights are charged after that.
+type CancellationPolicy struct {
+	HotelID               int32 `json:"hotel_id"`
+	FreeCancellationHours int32 `json:"free_cancellation_hours"`
+	PenaltyNights         int32 `json:"penalty_nights"`
+}
+
+// Rate overrides a room type's price for nights in [StartDate, EndDate).
+// An empty RoomType applies to every room type of the hotel. When rates
+// overlap, the one with the higher Priority wins.
+type Rate struct {
+	ID           int32     `json:"id"`
+	HotelID      int32     `json:"hotel_id"`
+	RoomType     string    `json:"room_type"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	WeekdayPrice float32   `json:"weekday_price"`
+	WeekendPrice float32   `json:"weekend_price"`
+	Priority     int32     `json:"priority"`
+}
+
+// StayDiscount takes Percent off stays of at least MinNights nights.
+type StayDiscount struct {
+	ID        int32   `json:"id"`
+	HotelID   int32   `json:"hotel_id"`
+	RoomType  string  `json:"room_type"`
+	MinNights int32   `json:"min_nights"`
+	Percent   float32 `json:"percent"`
+}
+
+type RatePlanRequest struct {
+	HotelID  int32  `json:"hotel_id"`
+	RoomType string `json:"room_type"`
+	// From and To limit the rates to those overlapping [From, To). Zero means no limit.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type RatePlan struct {
+	Rates     []*Rate         `json:"rates"`
+	Discounts []*StayDiscount `json:"discounts"`
+}
+
+type DeleteRateRequest struct {
+	HotelID int32 `json:"hotel_id"`
+	ID      int32 `json:"id"`
+}
+
+type NightPrice struct {
+	Date  time.Time `json:"date"`
+	Price float32   `json:"price"`
+}
+
+type Quote struct {
+	RoomID   int32         `json:"room_id"`
+	Nights   []*NightPrice `json:"nights"`
+	Subtotal float32       `json:"subtotal"`
+	Discount float32       `json:"discount"`
+	Total    float32       `json:"total"`
+}
+
+// SearchHotelsRequest filters the rooms that may be offered for a stay.
+// Empty strings and zero numbers mean no filter.
+type SearchHotelsRequest struct {
+	Location     string    `json:"location"`
+	RoomType     string    `json:"room_type"`
+	Guests       int32     `json:"guests"`
+	MinRating    int32     `json:"min_rating"`
+	MinPrice     float32   `json:"min_price"`
+	MaxPrice     float32   `json:"max_price"`
+	CheckInDate  time.Time `json:"check_in_date"`
+	CheckOutDate time.Time `json:"check_out_date"`
+	// Amenities must all be offered by the hotel or the room itself.
+	Amenities []string `json:"amenities"`
+}
+
+// SearchRoom is a room that matches a search. NightlyPrice is its total
+// for the stay divided by the number of nights.
+type SearchRoom struct {
+	ID            int32    `json:"id"`
+	HotelID       int32    `json:"hotel_id"`
+	RoomType      string   `json:"room_type"`
+	Capacity      int32    `json:"capacity"`
+	PricePerNight float32  `json:"price_per_night"`
+	NightlyPrice  float32  `json:"nightly_price"`
+	Total         float32  `json:"total"`
+	Amenities     []string `json:"amenities"`
+}
+
+type SearchHotel struct {
+	ID        int32         `json:"id"`
+	Name      string        `json:"name"`
+	Location  string        `json:"location"`
+	Rating    int32         `json:"rating"`
+	Address   string        `json:"address"`
+	Rooms     []*SearchRoom `json:"rooms"`
+	Amenities []string      `json:"amenities"`
+}
+
+// NearbyHotelsRequest asks for hotels within RadiusKm of a point.
+type NearbyHotelsRequest struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+	Limit     int32   `json:"limit"`
+}
+
+// NearbyHotel is a hotel found by NearbyHotels with its distance from the point.
+type NearbyHotel struct {
+	ID         int32   `json:"id"`
+	Name       string  `json:"name"`
+	Location   string  `json:"location"`
+	Rating     int32   `json:"rating"`
+	Address    string  `json:"address"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+const (
+	AmenityScopeHotel = "hotel"
+	AmenityScopeRoom  = "room"
+)
+
+// Amenity is an entry of the amenity catalog. Hotel amenities describe the
+// whole hotel (parking, pool), room amenities a single room (accessible).
+type Amenity struct {
+	ID    int32  `json:"id"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Scope string `json:"scope"`
+}
+
+// ListAmenitiesRequest lists the catalog, only one scope when Scope is set.
+type ListAmenitiesRequest struct {
+	Scope string `json:"scope"`
+}
+
+// SetAmenitiesRequest replaces the amenities of a hotel, or of one of its
+// rooms when RoomID is set.
+type SetAmenitiesRequest struct {
+	HotelID   int32    `json:"hotel_id"`
+	RoomID    int32    `json:"room_id"`
+	Amenities []string `json:"amenities"`
+}
+
+// RoomType is what a hotel sells: rooms of one type share capacity, beds
+// and base price. Name is fixed once created, rates, discounts and
+// bookings refer to the type by it.
+type RoomType struct {
+	ID               int32   `json:"id"`
+	HotelID          int32   `json:"hotel_id"`
+	Name             string  `json:"name"`
+	Capacity         int32   `json:"capacity"`
+	BedConfiguration string  `json:"bed_configuration"`
+	BasePrice        float32 `json:"base_price"`
+	Description      string  `json:"description"`
+}
+
+// GetRoomTypeRequest finds a room type of a hotel by ID or, when ID is
+// zero, by Name.
+type GetRoomTypeRequest struct {
+	HotelID int32  `json:"hotel_id"`
+	ID      int32  `json:"id"`
+	Name    string `json:"name"`
 }
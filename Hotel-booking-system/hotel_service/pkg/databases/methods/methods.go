@@ -3,12 +3,29 @@ package methods
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	sqlbuilder "hotel-service/pkg/databases/sql"
 	"hotel-service/models"
 	"log"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation and foreignKeyViolation are the Postgres error codes
+// raised when a row breaks a unique or a foreign key constraint.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
 )
 
+// isViolation reports whether err is a Postgres error with the given code.
+func isViolation(err error, code string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == code
+}
+
 type Database struct {
 	Db *sql.DB
 }
@@ -47,21 +64,28 @@ func (u *Database) GetHotel(ctx context.Context, req *models.GetHotelRequest) (*
 	var rooms []*models.UpdateRoomRequest
 
 	for rows.Next() {
-		var all models.UpdateRoomRequest
-		if err := rows.Scan(&all.HotelID, &all.ID, &all.RoomType, &all.PricePerNight, &all.Available); err != nil {
-			log.Println(err)
+		all, err := scanRoom(rows)
+		if err != nil {
 			return nil, err
 		}
-		rooms = append(rooms, &all)
+		rooms = append(rooms, all)
 	}
 
 	var res models.GetHotelResponse
 
-	if err := u.Db.QueryRow(query, args...).Scan(&res.ID, &res.Name, &res.Location, &res.Rating, &res.Address); err != nil {
+	if err := u.Db.QueryRow(query, args...).Scan(&res.ID, &res.Name, &res.Location, &res.Rating, &res.Address, &res.Latitude, &res.Longitude); err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	if err := u.roomAmenities(ctx, rooms); err != nil {
+		return nil, err
+	}
+	codes, err := u.amenityCodes(ctx, models.AmenityScopeHotel, []int32{res.ID})
+	if err != nil {
+		return nil, err
+	}
 	res.Rooms = rooms
+	res.Amenities = codes[res.ID]
 	return &res, nil
 }
 
@@ -81,7 +105,7 @@ func (u *Database) Gets(ctx context.Context, req *models.GetsRequest) ([]*models
 
 	for rows.Next() {
 		var all models.UpdateHotelRequest
-		if err := rows.Scan(&all.ID, &all.Name, &all.Location, &all.Rating,&all.Address); err != nil {
+		if err := rows.Scan(&all.ID, &all.Name, &all.Location, &all.Rating,&all.Address, &all.Latitude, &all.Longitude); err != nil {
 			log.Println(err)
 			return nil, err
 		}
@@ -90,6 +114,32 @@ func (u *Database) Gets(ctx context.Context, req *models.GetsRequest) ([]*models
 	return res, nil
 }
 
+// NearbyHotels returns the hotels around a point ordered by distance.
+func (u *Database) NearbyHotels(ctx context.Context, req *models.NearbyHotelsRequest) ([]*models.NearbyHotel, error) {
+	query, args, err := sqlbuilder.NearbyHotels(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*models.NearbyHotel
+	for rows.Next() {
+		var h models.NearbyHotel
+		if err := rows.Scan(&h.ID, &h.Name, &h.Location, &h.Rating, &h.Address, &h.Latitude, &h.Longitude, &h.DistanceKm); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		res = append(res, &h)
+	}
+	return res, rows.Err()
+}
+
 func (u *Database) UpdateHotel(ctx context.Context, req *models.UpdateHotelRequest) (*models.GeneralResponse, error) {
 	query, args, err := sqlbuilder.UpdateHotel(req)
 	if err != nil {
@@ -129,6 +179,9 @@ func (u *Database) CreateRoom(ctx context.Context, req *models.CreateRoomRequest
 	var id int
 	if err := u.Db.QueryRow(query, args...).Scan(&id); err != nil {
 		log.Println(err)
+		if isViolation(err, uniqueViolation) {
+			return nil, fmt.Errorf("%w: room number %q already exists", models.ErrInvalidRoom, req.RoomNumber)
+		}
 		return nil, err
 	}
 	return &models.GeneralResponse{Message: fmt.Sprintf("Room has been added with this id %v", id)}, nil
@@ -140,15 +193,91 @@ func (u *Database) GetRoom(ctx context.Context, req *models.GetRoomRequest) (*mo
 		log.Println(err)
 		return nil, err
 	}
+	res, err := scanRoom(u.Db.QueryRow(query, args...))
+	if err != nil {
+		return nil, err
+	}
+	if err := u.roomAmenities(ctx, []*models.UpdateRoomRequest{res}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// scanRoom reads a room selected with the builder's room columns.
+func scanRoom(row interface{ Scan(dest ...any) error }) (*models.UpdateRoomRequest, error) {
 	var res models.UpdateRoomRequest
 
-	if err := u.Db.QueryRow(query, args...).Scan(&res.HotelID, &res.ID, &res.RoomType, &res.PricePerNight, &res.Available); err != nil {
+	if err := row.Scan(&res.HotelID, &res.ID, &res.RoomType, &res.PricePerNight, &res.Available, &res.Capacity,
+		&res.RoomTypeID, &res.RoomNumber, &res.Floor); err != nil {
 		log.Println(err)
 		return nil, err
 	}
 	return &res, nil
 }
 
+// SearchRooms returns the hotels with rooms matching the search filters,
+// the rooms still unpriced.
+func (u *Database) SearchRooms(ctx context.Context, req *models.SearchHotelsRequest) ([]*models.SearchHotel, error) {
+	query, args, err := sqlbuilder.SearchRooms(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*models.SearchHotel
+	for rows.Next() {
+		var h models.SearchHotel
+		var room models.SearchRoom
+		var available bool
+		var roomTypeID, floor int32
+		var roomNumber string
+		if err := rows.Scan(&h.ID, &h.Name, &h.Location, &h.Rating, &h.Address,
+			&room.HotelID, &room.ID, &room.RoomType, &room.PricePerNight, &available, &room.Capacity,
+			&roomTypeID, &roomNumber, &floor); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		if len(res) == 0 || res[len(res)-1].ID != h.ID {
+			res = append(res, &h)
+		}
+		last := res[len(res)-1]
+		last.Rooms = append(last.Rooms, &room)
+	}
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var hotelIDs, roomIDs []int32
+	for _, h := range res {
+		hotelIDs = append(hotelIDs, h.ID)
+		for _, room := range h.Rooms {
+			roomIDs = append(roomIDs, room.ID)
+		}
+	}
+	hotelCodes, err := u.amenityCodes(ctx, models.AmenityScopeHotel, hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+	roomCodes, err := u.amenityCodes(ctx, models.AmenityScopeRoom, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range res {
+		h.Amenities = hotelCodes[h.ID]
+		for _, room := range h.Rooms {
+			room.Amenities = roomCodes[room.ID]
+		}
+	}
+	return res, nil
+}
+
 func (u *Database) GetRooms(ctx context.Context, req *models.GetRoomRequest) (*models.GetRoomResponse, error) {
 	query, args, err := sqlbuilder.GetsRoom(req)
 	if err != nil {
@@ -164,13 +293,14 @@ func (u *Database) GetRooms(ctx context.Context, req *models.GetRoomRequest) (*m
 	var rooms []*models.UpdateRoomRequest
 
 	for rows.Next() {
-		var all models.UpdateRoomRequest
-
-		if err := rows.Scan(&all.HotelID, &all.ID, &all.RoomType, &all.PricePerNight, &all.Available); err != nil {
-			log.Println(err)
+		all, err := scanRoom(rows)
+		if err != nil {
 			return nil, err
 		}
-		rooms = append(rooms, &all)
+		rooms = append(rooms, all)
+	}
+	if err := u.roomAmenities(ctx, rooms); err != nil {
+		return nil, err
 	}
 	return &models.GetRoomResponse{Rooms: rooms}, nil
 }
@@ -185,6 +315,9 @@ func (u *Database) UpdateRooms(ctx context.Context, req *models.UpdateRoomReques
 
 	if err := u.Db.QueryRow(query, args...).Scan(&id); err != nil {
 		log.Println(err)
+		if isViolation(err, uniqueViolation) {
+			return nil, fmt.Errorf("%w: room number %q already exists", models.ErrInvalidRoom, req.RoomNumber)
+		}
 		return nil, err
 	}
 	return &models.GeneralResponse{Message: fmt.Sprintf("room has been updated with this id %v", id)}, nil
@@ -203,3 +336,420 @@ func (u *Database) DeleteRoom(ctx context.Context, req *models.GetRoomRequest) (
 	}
 	return &models.GeneralResponse{Message: fmt.Sprintf("Room has been deleted with this id %v", req.ID)}, nil
 }
+
+func (u *Database) SetCancellationPolicy(ctx context.Context, req *models.CancellationPolicy) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.SetCancellationPolicy(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRow(query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Cancellation policy has been saved for hotel %v", id)}, nil
+}
+
+// GetCancellationPolicy returns the hotel's policy, or a zero policy
+// (free cancellation until check-in) when none has been set.
+func (u *Database) GetCancellationPolicy(ctx context.Context, req *models.GetHotelRequest) (*models.CancellationPolicy, error) {
+	query, args, err := sqlbuilder.GetCancellationPolicy(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var res models.CancellationPolicy
+	err = u.Db.QueryRow(query, args...).Scan(&res.HotelID, &res.FreeCancellationHours, &res.PenaltyNights)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.CancellationPolicy{HotelID: req.ID}, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *Database) CreateRate(ctx context.Context, req *models.Rate) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.CreateRate(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRow(query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Rate has been added with this id %v", id)}, nil
+}
+
+func (u *Database) DeleteRate(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.DeleteRate(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	_, err = u.Db.Exec(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Rate has been deleted with this id %v", req.ID)}, nil
+}
+
+func (u *Database) CreateStayDiscount(ctx context.Context, req *models.StayDiscount) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.CreateStayDiscount(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRow(query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Stay discount has been saved with this id %v", id)}, nil
+}
+
+func (u *Database) DeleteStayDiscount(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.DeleteStayDiscount(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	_, err = u.Db.Exec(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Stay discount has been deleted with this id %v", req.ID)}, nil
+}
+
+func (u *Database) GetRatePlan(ctx context.Context, req *models.RatePlanRequest) (*models.RatePlan, error) {
+	query, args, err := sqlbuilder.GetRates(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res models.RatePlan
+	for rows.Next() {
+		var all models.Rate
+		if err := rows.Scan(&all.ID, &all.HotelID, &all.RoomType, &all.StartDate, &all.EndDate, &all.WeekdayPrice, &all.WeekendPrice, &all.Priority); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		res.Rates = append(res.Rates, &all)
+	}
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	query, args, err = sqlbuilder.GetStayDiscounts(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	drows, err := u.Db.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer drows.Close()
+
+	for drows.Next() {
+		var all models.StayDiscount
+		if err := drows.Scan(&all.ID, &all.HotelID, &all.RoomType, &all.MinNights, &all.Percent); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		res.Discounts = append(res.Discounts, &all)
+	}
+	if err := drows.Err(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *Database) CreateAmenity(ctx context.Context, req *models.Amenity) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.CreateAmenity(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		if isViolation(err, uniqueViolation) {
+			return nil, fmt.Errorf("%w: %q already exists", models.ErrInvalidAmenity, req.Code)
+		}
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Amenity has been added with this id %v", id)}, nil
+}
+
+func (u *Database) ListAmenities(ctx context.Context, req *models.ListAmenitiesRequest) ([]*models.Amenity, error) {
+	query, args, err := sqlbuilder.ListAmenities(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*models.Amenity
+	for rows.Next() {
+		var all models.Amenity
+		if err := rows.Scan(&all.ID, &all.Code, &all.Name, &all.Scope); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		res = append(res, &all)
+	}
+	return res, rows.Err()
+}
+
+// SetAmenities replaces the amenities of a hotel, or of a room when
+// req.RoomID is set. Codes missing from the catalog of that scope fail
+// the whole request.
+func (u *Database) SetAmenities(ctx context.Context, req *models.SetAmenitiesRequest) (*models.GeneralResponse, error) {
+	scope, id := models.AmenityScopeHotel, req.HotelID
+	if req.RoomID != 0 {
+		scope, id = models.AmenityScopeRoom, req.RoomID
+	}
+
+	tx, err := u.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var amenityIDs []int32
+	if len(req.Amenities) > 0 {
+		query, args, err := sqlbuilder.AmenityIDs(scope, req.Amenities)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		found := make(map[string]bool)
+		for rows.Next() {
+			var amenityID int32
+			var code string
+			if err := rows.Scan(&amenityID, &code); err != nil {
+				rows.Close()
+				log.Println(err)
+				return nil, err
+			}
+			amenityIDs = append(amenityIDs, amenityID)
+			found[code] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		var missing []string
+		for _, code := range req.Amenities {
+			if !found[code] {
+				missing = append(missing, code)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("%w: no %s amenity %s", models.ErrInvalidAmenity, scope, strings.Join(missing, ", "))
+		}
+	}
+
+	query, args, err := sqlbuilder.DeleteAmenityLinks(scope, id)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if len(amenityIDs) > 0 {
+		query, args, err := sqlbuilder.CreateAmenityLinks(scope, id, amenityIDs)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Amenities have been saved for %s %v", scope, id)}, nil
+}
+
+// roomAmenities fills the amenities of rooms.
+func (u *Database) roomAmenities(ctx context.Context, rooms []*models.UpdateRoomRequest) error {
+	var ids []int32
+	for _, v := range rooms {
+		ids = append(ids, v.ID)
+	}
+	codes, err := u.amenityCodes(ctx, models.AmenityScopeRoom, ids)
+	if err != nil {
+		return err
+	}
+	for _, v := range rooms {
+		v.Amenities = codes[v.ID]
+	}
+	return nil
+}
+
+// amenityCodes returns the amenity codes of the hotels or rooms with the
+// given ids, depending on scope, keyed by id.
+func (u *Database) amenityCodes(ctx context.Context, scope string, ids []int32) (map[int32][]string, error) {
+	res := make(map[int32][]string)
+	if len(ids) == 0 {
+		return res, nil
+	}
+	query, args, err := sqlbuilder.AmenityCodes(scope, ids)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int32
+		var code string
+		if err := rows.Scan(&id, &code); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		res[id] = append(res[id], code)
+	}
+	return res, rows.Err()
+}
+
+func (u *Database) CreateRoomType(ctx context.Context, req *models.RoomType) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.CreateRoomType(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		if isViolation(err, uniqueViolation) {
+			return nil, fmt.Errorf("%w: %q already exists", models.ErrInvalidRoomType, req.Name)
+		}
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Room type has been added with this id %v", id)}, nil
+}
+
+func (u *Database) GetRoomTypes(ctx context.Context, req *models.GetHotelRequest) ([]*models.RoomType, error) {
+	query, args, err := sqlbuilder.GetRoomTypes(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	rows, err := u.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*models.RoomType
+	for rows.Next() {
+		all, err := scanRoomType(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, all)
+	}
+	return res, rows.Err()
+}
+
+func (u *Database) GetRoomType(ctx context.Context, req *models.GetRoomTypeRequest) (*models.RoomType, error) {
+	query, args, err := sqlbuilder.GetRoomType(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res, err := scanRoomType(u.Db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		if req.ID != 0 {
+			return nil, fmt.Errorf("%w: no room type with id %v in hotel %v", models.ErrInvalidRoomType, req.ID, req.HotelID)
+		}
+		return nil, fmt.Errorf("%w: no room type %q in hotel %v", models.ErrInvalidRoomType, req.Name, req.HotelID)
+	}
+	return res, err
+}
+
+// scanRoomType reads a room type selected with the builder's room type columns.
+func scanRoomType(row interface{ Scan(dest ...any) error }) (*models.RoomType, error) {
+	var res models.RoomType
+
+	if err := row.Scan(&res.ID, &res.HotelID, &res.Name, &res.Capacity, &res.BedConfiguration, &res.BasePrice, &res.Description); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *Database) UpdateRoomType(ctx context.Context, req *models.RoomType) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.UpdateRoomType(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var id int
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		log.Println(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: no room type with id %v in hotel %v", models.ErrInvalidRoomType, req.ID, req.HotelID)
+		}
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Room type has been updated with this id %v", id)}, nil
+}
+
+func (u *Database) DeleteRoomType(ctx context.Context, req *models.DeleteRateRequest) (*models.GeneralResponse, error) {
+	query, args, err := sqlbuilder.DeleteRoomType(req)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if _, err := u.Db.ExecContext(ctx, query, args...); err != nil {
+		log.Println(err)
+		if isViolation(err, foreignKeyViolation) {
+			return nil, fmt.Errorf("%w: room type %v still has rooms", models.ErrInvalidRoomType, req.ID)
+		}
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Room type has been deleted with this id %v", req.ID)}, nil
+}
@@ -10,8 +10,8 @@ import (
 
 func CreateHotel(req *models.CreateHotelRequest) (string, []interface{}, error) {
 	query, args, err := squirrel.Insert("hotels").
-		Columns("name", "location", "rating", "address").
-		Values(req.Name, req.Location, req.Rating, req.Address).
+		Columns("name", "location", "rating", "address", "latitude", "longitude").
+		Values(req.Name, req.Location, req.Rating, req.Address, req.Latitude, req.Longitude).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
 		ToSql()
@@ -22,8 +22,17 @@ func CreateHotel(req *models.CreateHotelRequest) (string, []interface{}, error)
 	return query, args, nil
 }
 
+// hotelColumns and roomColumns list the columns in the order Database scans them.
+// Room type, price and capacity of a room come from its room type, so room
+// queries select from roomTable.
+var (
+	hotelColumns = []string{"id", "name", "location", "rating", "address", "latitude", "longitude"}
+	roomColumns  = []string{"r.hotel_id", "r.id", "t.name", "t.base_price", "r.available", "t.capacity", "r.room_type_id", "r.room_number", "r.floor"}
+	roomTable    = "rooms r JOIN room_types t ON t.id = r.room_type_id"
+)
+
 func GetHotel(req *models.GetHotelRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
+	query, args, err := squirrel.Select(hotelColumns...).
 		From("hotels").
 		Where(squirrel.Eq{"id": req.ID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -36,7 +45,7 @@ func GetHotel(req *models.GetHotelRequest) (string, []interface{}, error) {
 }
 
 func GetsHotel(req *models.GetsRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
+	query, args, err := squirrel.Select(hotelColumns...).
 		From("hotels").
 		ToSql()
 	if err != nil {
@@ -61,6 +70,10 @@ func UpdateHotel(req *models.UpdateHotelRequest) (string, []interface{}, error)
 	if req.Address != "" {
 		setMap["address"] = req.Address
 	}
+	if req.Latitude != nil && req.Longitude != nil {
+		setMap["latitude"] = *req.Latitude
+		setMap["longitude"] = *req.Longitude
+	}
 
 	if len(setMap) == 0 {
 		return "", nil, fmt.Errorf("no fields to update")
@@ -78,6 +91,28 @@ func UpdateHotel(req *models.UpdateHotelRequest) (string, []interface{}, error)
 	return query, args, nil
 }
 
+// NearbyHotels selects the hotels within req.RadiusKm of the point, nearest
+// first. The earth_box condition lets Postgres use the coordinates index,
+// earth_distance then drops the corners of the box.
+func NearbyHotels(req *models.NearbyHotelsRequest) (string, []interface{}, error) {
+	radius := req.RadiusKm * 1000
+	query, args, err := squirrel.Select(hotelColumns...).
+		Column(squirrel.Expr("earth_distance(ll_to_earth(?, ?), ll_to_earth(latitude, longitude)) / 1000 AS distance_km", req.Latitude, req.Longitude)).
+		From("hotels").
+		Where("latitude IS NOT NULL").
+		Where(squirrel.Expr("earth_box(ll_to_earth(?, ?), ?) @> ll_to_earth(latitude, longitude)", req.Latitude, req.Longitude, radius)).
+		Where(squirrel.Expr("earth_distance(ll_to_earth(?, ?), ll_to_earth(latitude, longitude)) <= ?", req.Latitude, req.Longitude, radius)).
+		OrderBy("distance_km", "id").
+		Limit(uint64(req.Limit)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
 func DeleteHotel(req *models.GetHotelRequest) (string, []interface{}, error) {
 	query, args, err := squirrel.Delete("hotels").
 		Where(squirrel.Eq{"id": req.ID}).
@@ -92,8 +127,8 @@ func DeleteHotel(req *models.GetHotelRequest) (string, []interface{}, error) {
 
 func CreateRoom(req *models.CreateRoomRequest) (string, []interface{}, error) {
 	query, args, err := squirrel.Insert("rooms").
-		Columns("hotel_id", "room_type", "price_per_night").
-		Values(req.HotelID, req.RoomType, req.PricePerNight).
+		Columns("hotel_id", "room_type_id", "room_number", "floor").
+		Values(req.HotelID, req.RoomTypeID, req.RoomNumber, req.Floor).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
 		ToSql()
@@ -105,9 +140,9 @@ func CreateRoom(req *models.CreateRoomRequest) (string, []interface{}, error) {
 }
 
 func GetRoom(req *models.GetRoomRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
-		From("rooms").
-		Where(squirrel.Eq{"id": req.ID, "hotel_id": req.HotelID}).
+	query, args, err := squirrel.Select(roomColumns...).
+		From(roomTable).
+		Where(squirrel.Eq{"r.id": req.ID, "r.hotel_id": req.HotelID}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -117,10 +152,12 @@ func GetRoom(req *models.GetRoomRequest) (string, []interface{}, error) {
 	return query, args, nil
 }
 
+// GetsRoom selects every room of a hotel. Whether a room is free is decided
+// by the booking dates in the booking service, not by rooms.available.
 func GetsRoom(req *models.GetRoomRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
-		From("rooms").
-		Where(squirrel.Eq{"available": true, "hotel_id": req.HotelID}).
+	query, args, err := squirrel.Select(roomColumns...).
+		From(roomTable).
+		Where(squirrel.Eq{"r.hotel_id": req.HotelID}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -137,11 +174,14 @@ func UpdateRoom(req *models.UpdateRoomRequest) (string, []interface{}, error) {
 	}else if !req.Available{
 		setMap["available"] = req.Available
 	}
-	if req.RoomType != ""{
-		setMap["room_type"] = req.RoomType
+	if req.RoomTypeID != 0 {
+		setMap["room_type_id"] = req.RoomTypeID
+	}
+	if req.RoomNumber != "" {
+		setMap["room_number"] = req.RoomNumber
 	}
-	if req.PricePerNight != 0 {
-		setMap["price_per_night"] = req.PricePerNight
+	if req.Floor != nil {
+		setMap["floor"] = *req.Floor
 	}
 
 	if len(setMap) == 0 {
@@ -174,9 +214,372 @@ func DeleteRoom(req *models.GetRoomRequest) (string, []interface{}, error) {
 }
 
 func GetRoomForHotel(req int) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("*").
-		From("rooms").
-		Where(squirrel.Eq{"hotel_id": req}).
+	query, args, err := squirrel.Select(roomColumns...).
+		From(roomTable).
+		Where(squirrel.Eq{"r.hotel_id": req}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// SearchRooms selects the rooms matching the search filters together with
+// their hotel, ordered by hotel. Prices are filtered after pricing the stay and
+// booked rooms by the booking service.
+func SearchRooms(req *models.SearchHotelsRequest) (string, []interface{}, error) {
+	columns := append([]string{"h.id", "h.name", "h.location", "h.rating", "h.address"}, roomColumns...)
+	builder := squirrel.Select(columns...).
+		From(roomTable).
+		Join("hotels h ON h.id = r.hotel_id")
+	if req.Location != "" {
+		builder = builder.Where(squirrel.ILike{"h.location": "%" + req.Location + "%"})
+	}
+	if req.RoomType != "" {
+		builder = builder.Where(squirrel.Eq{"t.name": req.RoomType})
+	}
+	if req.Guests != 0 {
+		builder = builder.Where(squirrel.GtOrEq{"t.capacity": req.Guests})
+	}
+	if req.MinRating != 0 {
+		builder = builder.Where(squirrel.GtOrEq{"h.rating": req.MinRating})
+	}
+	// Every amenity must be offered by the hotel or by the room itself
+	for _, code := range req.Amenities {
+		builder = builder.Where(squirrel.Or{
+			squirrel.Expr("EXISTS (SELECT 1 FROM hotel_amenities ha JOIN amenities a ON a.id = ha.amenity_id WHERE ha.hotel_id = h.id AND a.code = ?)", code),
+			squirrel.Expr("EXISTS (SELECT 1 FROM room_amenities ra JOIN amenities a ON a.id = ra.amenity_id WHERE ra.room_id = r.id AND a.code = ?)", code),
+		})
+	}
+	query, args, err := builder.
+		OrderBy("h.id", "r.id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func SetCancellationPolicy(req *models.CancellationPolicy) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("cancellation_policies").
+		Columns("hotel_id", "free_cancellation_hours", "penalty_nights").
+		Values(req.HotelID, req.FreeCancellationHours, req.PenaltyNights).
+		Suffix("ON CONFLICT (hotel_id) DO UPDATE SET free_cancellation_hours = EXCLUDED.free_cancellation_hours, penalty_nights = EXCLUDED.penalty_nights RETURNING hotel_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetCancellationPolicy(req *models.GetHotelRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("hotel_id", "free_cancellation_hours", "penalty_nights").
+		From("cancellation_policies").
+		Where(squirrel.Eq{"hotel_id": req.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateRate(req *models.Rate) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("rates").
+		Columns("hotel_id", "room_type", "start_date", "end_date", "weekday_price", "weekend_price", "priority").
+		Values(req.HotelID, req.RoomType, req.StartDate, req.EndDate, req.WeekdayPrice, req.WeekendPrice, req.Priority).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// GetRates lists the rates of a hotel, most important first: higher priority,
+// then rates for the room type over hotel-wide ones, then the newest.
+func GetRates(req *models.RatePlanRequest) (string, []interface{}, error) {
+	builder := squirrel.Select("id", "hotel_id", "room_type", "start_date", "end_date", "weekday_price", "weekend_price", "priority").
+		From("rates").
+		Where(squirrel.Eq{"hotel_id": req.HotelID})
+	if req.RoomType != "" {
+		builder = builder.Where(squirrel.Eq{"room_type": []string{req.RoomType, ""}})
+	}
+	if !req.From.IsZero() && !req.To.IsZero() {
+		builder = builder.Where(squirrel.Lt{"start_date": req.To}).Where(squirrel.Gt{"end_date": req.From})
+	}
+	query, args, err := builder.
+		OrderBy("priority DESC", "room_type <> '' DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteRate(req *models.DeleteRateRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Delete("rates").
+		Where(squirrel.Eq{"id": req.ID, "hotel_id": req.HotelID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateStayDiscount(req *models.StayDiscount) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("stay_discounts").
+		Columns("hotel_id", "room_type", "min_nights", "percent").
+		Values(req.HotelID, req.RoomType, req.MinNights, req.Percent).
+		Suffix("ON CONFLICT (hotel_id, room_type, min_nights) DO UPDATE SET percent = EXCLUDED.percent RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetStayDiscounts(req *models.RatePlanRequest) (string, []interface{}, error) {
+	builder := squirrel.Select("id", "hotel_id", "room_type", "min_nights", "percent").
+		From("stay_discounts").
+		Where(squirrel.Eq{"hotel_id": req.HotelID})
+	if req.RoomType != "" {
+		builder = builder.Where(squirrel.Eq{"room_type": []string{req.RoomType, ""}})
+	}
+	query, args, err := builder.
+		OrderBy("min_nights", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteStayDiscount(req *models.DeleteRateRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Delete("stay_discounts").
+		Where(squirrel.Eq{"id": req.ID, "hotel_id": req.HotelID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateAmenity(req *models.Amenity) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("amenities").
+		Columns("code", "name", "scope").
+		Values(req.Code, req.Name, req.Scope).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func ListAmenities(req *models.ListAmenitiesRequest) (string, []interface{}, error) {
+	builder := squirrel.Select("id", "code", "name", "scope").
+		From("amenities")
+	if req.Scope != "" {
+		builder = builder.Where(squirrel.Eq{"scope": req.Scope})
+	}
+	query, args, err := builder.
+		OrderBy("scope", "code").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// AmenityIDs selects the id and code of the catalog entries of scope with the given codes.
+func AmenityIDs(scope string, codes []string) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id", "code").
+		From("amenities").
+		Where(squirrel.Eq{"scope": scope, "code": codes}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// amenityLinks names the table linking amenities of a scope to their owner
+// and the owner's column in it.
+var amenityLinks = map[string]struct{ table, owner string }{
+	models.AmenityScopeHotel: {"hotel_amenities", "hotel_id"},
+	models.AmenityScopeRoom:  {"room_amenities", "room_id"},
+}
+
+// AmenityCodes selects owner id and amenity code pairs for the hotels or
+// rooms with the given ids, depending on scope.
+func AmenityCodes(scope string, ids []int32) (string, []interface{}, error) {
+	link, ok := amenityLinks[scope]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown amenity scope %q", scope)
+	}
+	query, args, err := squirrel.Select("l."+link.owner, "a.code").
+		From(link.table + " l").
+		Join("amenities a ON a.id = l.amenity_id").
+		Where(squirrel.Eq{"l." + link.owner: ids}).
+		OrderBy("l."+link.owner, "a.code").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteAmenityLinks(scope string, id int32) (string, []interface{}, error) {
+	link, ok := amenityLinks[scope]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown amenity scope %q", scope)
+	}
+	query, args, err := squirrel.Delete(link.table).
+		Where(squirrel.Eq{link.owner: id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func CreateAmenityLinks(scope string, id int32, amenityIDs []int32) (string, []interface{}, error) {
+	link, ok := amenityLinks[scope]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown amenity scope %q", scope)
+	}
+	builder := squirrel.Insert(link.table).
+		Columns(link.owner, "amenity_id")
+	for _, v := range amenityIDs {
+		builder = builder.Values(id, v)
+	}
+	query, args, err := builder.
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+var roomTypeColumns = []string{"id", "hotel_id", "name", "capacity", "bed_configuration", "base_price", "description"}
+
+func CreateRoomType(req *models.RoomType) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("room_types").
+		Columns("hotel_id", "name", "capacity", "bed_configuration", "base_price", "description").
+		Values(req.HotelID, req.Name, req.Capacity, req.BedConfiguration, req.BasePrice, req.Description).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetRoomTypes(req *models.GetHotelRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select(roomTypeColumns...).
+		From("room_types").
+		Where(squirrel.Eq{"hotel_id": req.ID}).
+		OrderBy("name", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// GetRoomType selects a room type of the hotel by id or, when the id is zero, by name.
+func GetRoomType(req *models.GetRoomTypeRequest) (string, []interface{}, error) {
+	builder := squirrel.Select(roomTypeColumns...).
+		From("room_types").
+		Where(squirrel.Eq{"hotel_id": req.HotelID})
+	if req.ID != 0 {
+		builder = builder.Where(squirrel.Eq{"id": req.ID})
+	} else {
+		builder = builder.Where(squirrel.Eq{"name": req.Name})
+	}
+	query, args, err := builder.
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// UpdateRoomType changes everything but the name, which rates, discounts and
+// bookings refer to.
+func UpdateRoomType(req *models.RoomType) (string, []interface{}, error) {
+	setMap := make(map[string]interface{})
+
+	if req.Capacity != 0 {
+		setMap["capacity"] = req.Capacity
+	}
+	if req.BedConfiguration != "" {
+		setMap["bed_configuration"] = req.BedConfiguration
+	}
+	if req.BasePrice != 0 {
+		setMap["base_price"] = req.BasePrice
+	}
+	if req.Description != "" {
+		setMap["description"] = req.Description
+	}
+
+	if len(setMap) == 0 {
+		return "", nil, fmt.Errorf("no fields to update")
+	}
+	query, args, err := squirrel.Update("room_types").
+		SetMap(setMap).
+		Where(squirrel.Eq{"id": req.ID, "hotel_id": req.HotelID}).
+		PlaceholderFormat(squirrel.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func DeleteRoomType(req *models.DeleteRateRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Delete("room_types").
+		Where(squirrel.Eq{"id": req.ID, "hotel_id": req.HotelID}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
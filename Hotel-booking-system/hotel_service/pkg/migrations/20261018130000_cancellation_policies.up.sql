@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS cancellation_policies (
+    hotel_id INT PRIMARY KEY,
+    free_cancellation_hours INT NOT NULL DEFAULT 0 CHECK (free_cancellation_hours >= 0),
+    penalty_nights INT NOT NULL DEFAULT 0 CHECK (penalty_nights >= 0)
+);
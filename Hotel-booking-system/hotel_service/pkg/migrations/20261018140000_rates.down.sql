@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS stay_discounts;
+DROP TABLE IF EXISTS rates;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS rates (
+    id SERIAL PRIMARY KEY,
+    hotel_id INT NOT NULL,
+    room_type VARCHAR(100) NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    weekday_price FLOAT NOT NULL CHECK (weekday_price > 0),
+    weekend_price FLOAT NOT NULL CHECK (weekend_price > 0),
+    priority INT NOT NULL DEFAULT 0,
+    CHECK (end_date > start_date)
+);
+
+CREATE INDEX IF NOT EXISTS rates_hotel_dates_idx ON rates (hotel_id, start_date, end_date);
+
+CREATE TABLE IF NOT EXISTS stay_discounts (
+    id SERIAL PRIMARY KEY,
+    hotel_id INT NOT NULL,
+    room_type VARCHAR(100) NOT NULL DEFAULT '',
+    min_nights INT NOT NULL CHECK (min_nights > 0),
+    percent FLOAT NOT NULL CHECK (percent > 0 AND percent < 100),
+    UNIQUE (hotel_id, room_type, min_nights)
+);
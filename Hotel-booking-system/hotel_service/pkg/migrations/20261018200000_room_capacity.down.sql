@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS rooms_search_idx;
+ALTER TABLE rooms DROP COLUMN IF EXISTS capacity;
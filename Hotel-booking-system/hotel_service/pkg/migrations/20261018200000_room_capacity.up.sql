@@ -0,0 +1,3 @@
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS capacity INT NOT NULL DEFAULT 2 CHECK (capacity > 0);
+
+CREATE INDEX IF NOT EXISTS rooms_search_idx ON rooms (hotel_id, room_type, capacity) WHERE available;
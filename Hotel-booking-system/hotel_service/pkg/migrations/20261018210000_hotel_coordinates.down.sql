@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS hotels_coordinates_idx;
+ALTER TABLE hotels DROP CONSTRAINT IF EXISTS hotels_coordinates_pair;
+ALTER TABLE hotels DROP COLUMN IF EXISTS latitude, DROP COLUMN IF EXISTS longitude;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS amenities (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('hotel', 'room'))
+);
+
+INSERT INTO amenities (code, name, scope) VALUES
+    ('wifi', 'Wi-Fi', 'hotel'),
+    ('parking', 'Parking', 'hotel'),
+    ('pool', 'Swimming pool', 'hotel'),
+    ('pets_allowed', 'Pets allowed', 'hotel'),
+    ('accessible', 'Accessible room', 'room')
+ON CONFLICT (code) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS hotel_amenities (
+    hotel_id INT NOT NULL REFERENCES hotels (id) ON DELETE CASCADE,
+    amenity_id INT NOT NULL REFERENCES amenities (id) ON DELETE CASCADE,
+    PRIMARY KEY (hotel_id, amenity_id)
+);
+
+CREATE TABLE IF NOT EXISTS room_amenities (
+    room_id INT NOT NULL REFERENCES rooms (id) ON DELETE CASCADE,
+    amenity_id INT NOT NULL REFERENCES amenities (id) ON DELETE CASCADE,
+    PRIMARY KEY (room_id, amenity_id)
+);
+
+CREATE INDEX IF NOT EXISTS hotel_amenities_amenity_idx ON hotel_amenities (amenity_id);
+CREATE INDEX IF NOT EXISTS room_amenities_amenity_idx ON room_amenities (amenity_id);
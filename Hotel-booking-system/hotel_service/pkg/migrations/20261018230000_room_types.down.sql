@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS rooms_room_type_idx;
+
+ALTER TABLE rooms
+    DROP CONSTRAINT IF EXISTS rooms_hotel_number_key,
+    ALTER COLUMN hotel_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS room_type VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS price_per_night FLOAT,
+    ADD COLUMN IF NOT EXISTS capacity INT NOT NULL DEFAULT 2 CHECK (capacity > 0);
+
+UPDATE rooms r SET room_type = t.name, price_per_night = t.base_price, capacity = t.capacity
+FROM room_types t
+WHERE t.id = r.room_type_id;
+
+ALTER TABLE rooms
+    DROP COLUMN IF EXISTS room_type_id,
+    DROP COLUMN IF EXISTS room_number,
+    DROP COLUMN IF EXISTS floor;
+
+CREATE INDEX IF NOT EXISTS rooms_search_idx ON rooms (hotel_id, room_type, capacity) WHERE available;
+
+DROP TABLE IF EXISTS room_types;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS room_types (
+    id SERIAL PRIMARY KEY,
+    hotel_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    capacity INT NOT NULL DEFAULT 2 CHECK (capacity > 0),
+    bed_configuration VARCHAR(100) NOT NULL DEFAULT '',
+    base_price FLOAT NOT NULL CHECK (base_price >= 0),
+    description TEXT NOT NULL DEFAULT '',
+    UNIQUE (hotel_id, name)
+);
+
+-- Rooms without a hotel were never bookable, a room type needs one.
+DELETE FROM rooms WHERE hotel_id IS NULL;
+
+-- Every existing room_type becomes a room type; rooms of one type may have
+-- been priced differently, the most common price becomes the base price.
+INSERT INTO room_types (hotel_id, name, capacity, base_price)
+SELECT hotel_id, COALESCE(room_type, ''), MAX(capacity), COALESCE(mode() WITHIN GROUP (ORDER BY price_per_night), 0)
+FROM rooms
+GROUP BY hotel_id, COALESCE(room_type, '')
+ON CONFLICT (hotel_id, name) DO NOTHING;
+
+ALTER TABLE rooms
+    ADD COLUMN IF NOT EXISTS room_type_id INT REFERENCES room_types (id),
+    ADD COLUMN IF NOT EXISTS room_number VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS floor INT NOT NULL DEFAULT 0;
+
+UPDATE rooms r SET room_type_id = t.id, room_number = r.id::text
+FROM room_types t
+WHERE t.hotel_id = r.hotel_id AND t.name = COALESCE(r.room_type, '');
+
+DROP INDEX IF EXISTS rooms_search_idx;
+
+ALTER TABLE rooms
+    ALTER COLUMN hotel_id SET NOT NULL,
+    ALTER COLUMN room_type_id SET NOT NULL,
+    ALTER COLUMN room_number SET NOT NULL,
+    ADD CONSTRAINT rooms_hotel_number_key UNIQUE (hotel_id, room_number),
+    DROP COLUMN IF EXISTS room_type,
+    DROP COLUMN IF EXISTS price_per_night,
+    DROP COLUMN IF EXISTS capacity;
+
+CREATE INDEX IF NOT EXISTS rooms_room_type_idx ON rooms (room_type_id) WHERE available;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS rooms_room_type_idx;
+CREATE INDEX IF NOT EXISTS rooms_room_type_idx ON rooms (room_type_id) WHERE available;
@@ -0,0 +1,7 @@
+-- Bookings no longer flip rooms.available, free rooms are found by booking
+-- dates, so the room type index covers every room now that queries don't
+-- filter on available. The rooms the old booking flow marked unavailable
+-- are only known to the booking service, which makes them available again
+-- itself; rooms staff took out of service keep their flag.
+DROP INDEX IF EXISTS rooms_room_type_idx;
+CREATE INDEX IF NOT EXISTS rooms_room_type_idx ON rooms (room_type_id);
@@ -19,16 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Hotel_CreateHotel_FullMethodName = "/Hotel/CreateHotel"
-	Hotel_GetHotel_FullMethodName    = "/Hotel/GetHotel"
-	Hotel_Gets_FullMethodName        = "/Hotel/Gets"
-	Hotel_Update_FullMethodName      = "/Hotel/Update"
-	Hotel_Delte_FullMethodName       = "/Hotel/Delte"
-	Hotel_CreateRoom_FullMethodName  = "/Hotel/CreateRoom"
-	Hotel_Get_FullMethodName         = "/Hotel/Get"
-	Hotel_GetRooms_FullMethodName    = "/Hotel/GetRooms"
-	Hotel_UpdateRoom_FullMethodName  = "/Hotel/UpdateRoom"
-	Hotel_DeleteRoom_FullMethodName  = "/Hotel/DeleteRoom"
+	Hotel_CreateHotel_FullMethodName           = "/Hotel/CreateHotel"
+	Hotel_GetHotel_FullMethodName              = "/Hotel/GetHotel"
+	Hotel_Gets_FullMethodName                  = "/Hotel/Gets"
+	Hotel_Update_FullMethodName                = "/Hotel/Update"
+	Hotel_Delte_FullMethodName                 = "/Hotel/Delte"
+	Hotel_CreateRoom_FullMethodName            = "/Hotel/CreateRoom"
+	Hotel_Get_FullMethodName                   = "/Hotel/Get"
+	Hotel_GetRooms_FullMethodName              = "/Hotel/GetRooms"
+	Hotel_UpdateRoom_FullMethodName            = "/Hotel/UpdateRoom"
+	Hotel_DeleteRoom_FullMethodName            = "/Hotel/DeleteRoom"
+	Hotel_SetCancellationPolicy_FullMethodName = "/Hotel/SetCancellationPolicy"
+	Hotel_GetCancellationPolicy_FullMethodName = "/Hotel/GetCancellationPolicy"
+	Hotel_CreateRate_FullMethodName            = "/Hotel/CreateRate"
+	Hotel_DeleteRate_FullMethodName            = "/Hotel/DeleteRate"
+	Hotel_CreateStayDiscount_FullMethodName    = "/Hotel/CreateStayDiscount"
+	Hotel_DeleteStayDiscount_FullMethodName    = "/Hotel/DeleteStayDiscount"
+	Hotel_GetRatePlan_FullMethodName           = "/Hotel/GetRatePlan"
+	Hotel_Quote_FullMethodName                 = "/Hotel/Quote"
+	Hotel_SearchHotels_FullMethodName          = "/Hotel/SearchHotels"
+	Hotel_NearbyHotels_FullMethodName          = "/Hotel/NearbyHotels"
+	Hotel_CreateAmenity_FullMethodName         = "/Hotel/CreateAmenity"
+	Hotel_ListAmenities_FullMethodName         = "/Hotel/ListAmenities"
+	Hotel_SetHotelAmenities_FullMethodName     = "/Hotel/SetHotelAmenities"
+	Hotel_SetRoomAmenities_FullMethodName      = "/Hotel/SetRoomAmenities"
+	Hotel_CreateRoomType_FullMethodName        = "/Hotel/CreateRoomType"
+	Hotel_GetRoomTypes_FullMethodName          = "/Hotel/GetRoomTypes"
+	Hotel_UpdateRoomType_FullMethodName        = "/Hotel/UpdateRoomType"
+	Hotel_DeleteRoomType_FullMethodName        = "/Hotel/DeleteRoomType"
 )
 
 // HotelClient is the client API for Hotel service.
@@ -45,6 +63,24 @@ type HotelClient interface {
 	GetRooms(ctx context.Context, in *GetroomRequest, opts ...grpc.CallOption) (*GetroomResponse, error)
 	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
 	DeleteRoom(ctx context.Context, in *GetroomRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	SetCancellationPolicy(ctx context.Context, in *CancellationPolicy, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetCancellationPolicy(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*CancellationPolicy, error)
+	CreateRate(ctx context.Context, in *Rate, opts ...grpc.CallOption) (*GeneralResponse, error)
+	DeleteRate(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	CreateStayDiscount(ctx context.Context, in *StayDiscount, opts ...grpc.CallOption) (*GeneralResponse, error)
+	DeleteStayDiscount(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetRatePlan(ctx context.Context, in *RatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error)
+	Quote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	SearchHotels(ctx context.Context, in *SearchHotelsRequest, opts ...grpc.CallOption) (*SearchHotelsResponse, error)
+	NearbyHotels(ctx context.Context, in *NearbyHotelsRequest, opts ...grpc.CallOption) (*NearbyHotelsResponse, error)
+	CreateAmenity(ctx context.Context, in *Amenity, opts ...grpc.CallOption) (*GeneralResponse, error)
+	ListAmenities(ctx context.Context, in *ListAmenitiesRequest, opts ...grpc.CallOption) (*ListAmenitiesResponse, error)
+	SetHotelAmenities(ctx context.Context, in *SetAmenitiesRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	SetRoomAmenities(ctx context.Context, in *SetAmenitiesRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	CreateRoomType(ctx context.Context, in *RoomType, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetRoomTypes(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*RoomTypesResponse, error)
+	UpdateRoomType(ctx context.Context, in *RoomType, opts ...grpc.CallOption) (*GeneralResponse, error)
+	DeleteRoomType(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
 }
 
 type hotelClient struct {
@@ -155,6 +191,186 @@ func (c *hotelClient) DeleteRoom(ctx context.Context, in *GetroomRequest, opts .
 	return out, nil
 }
 
+func (c *hotelClient) SetCancellationPolicy(ctx context.Context, in *CancellationPolicy, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_SetCancellationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) GetCancellationPolicy(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*CancellationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancellationPolicy)
+	err := c.cc.Invoke(ctx, Hotel_GetCancellationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) CreateRate(ctx context.Context, in *Rate, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_CreateRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) DeleteRate(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_DeleteRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) CreateStayDiscount(ctx context.Context, in *StayDiscount, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_CreateStayDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) DeleteStayDiscount(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_DeleteStayDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) GetRatePlan(ctx context.Context, in *RatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePlan)
+	err := c.cc.Invoke(ctx, Hotel_GetRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) Quote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, Hotel_Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) SearchHotels(ctx context.Context, in *SearchHotelsRequest, opts ...grpc.CallOption) (*SearchHotelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchHotelsResponse)
+	err := c.cc.Invoke(ctx, Hotel_SearchHotels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) NearbyHotels(ctx context.Context, in *NearbyHotelsRequest, opts ...grpc.CallOption) (*NearbyHotelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearbyHotelsResponse)
+	err := c.cc.Invoke(ctx, Hotel_NearbyHotels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) CreateAmenity(ctx context.Context, in *Amenity, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_CreateAmenity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) ListAmenities(ctx context.Context, in *ListAmenitiesRequest, opts ...grpc.CallOption) (*ListAmenitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAmenitiesResponse)
+	err := c.cc.Invoke(ctx, Hotel_ListAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) SetHotelAmenities(ctx context.Context, in *SetAmenitiesRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_SetHotelAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) SetRoomAmenities(ctx context.Context, in *SetAmenitiesRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_SetRoomAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) CreateRoomType(ctx context.Context, in *RoomType, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_CreateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) GetRoomTypes(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*RoomTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomTypesResponse)
+	err := c.cc.Invoke(ctx, Hotel_GetRoomTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) UpdateRoomType(ctx context.Context, in *RoomType, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_UpdateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelClient) DeleteRoomType(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, Hotel_DeleteRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HotelServer is the server API for Hotel service.
 // All implementations must embed UnimplementedHotelServer
 // for forward compatibility.
@@ -169,6 +385,24 @@ type HotelServer interface {
 	GetRooms(context.Context, *GetroomRequest) (*GetroomResponse, error)
 	UpdateRoom(context.Context, *UpdateRoomRequest) (*GeneralResponse, error)
 	DeleteRoom(context.Context, *GetroomRequest) (*GeneralResponse, error)
+	SetCancellationPolicy(context.Context, *CancellationPolicy) (*GeneralResponse, error)
+	GetCancellationPolicy(context.Context, *GetHotelRequest) (*CancellationPolicy, error)
+	CreateRate(context.Context, *Rate) (*GeneralResponse, error)
+	DeleteRate(context.Context, *DeleteRateRequest) (*GeneralResponse, error)
+	CreateStayDiscount(context.Context, *StayDiscount) (*GeneralResponse, error)
+	DeleteStayDiscount(context.Context, *DeleteRateRequest) (*GeneralResponse, error)
+	GetRatePlan(context.Context, *RatePlanRequest) (*RatePlan, error)
+	Quote(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	SearchHotels(context.Context, *SearchHotelsRequest) (*SearchHotelsResponse, error)
+	NearbyHotels(context.Context, *NearbyHotelsRequest) (*NearbyHotelsResponse, error)
+	CreateAmenity(context.Context, *Amenity) (*GeneralResponse, error)
+	ListAmenities(context.Context, *ListAmenitiesRequest) (*ListAmenitiesResponse, error)
+	SetHotelAmenities(context.Context, *SetAmenitiesRequest) (*GeneralResponse, error)
+	SetRoomAmenities(context.Context, *SetAmenitiesRequest) (*GeneralResponse, error)
+	CreateRoomType(context.Context, *RoomType) (*GeneralResponse, error)
+	GetRoomTypes(context.Context, *GetHotelRequest) (*RoomTypesResponse, error)
+	UpdateRoomType(context.Context, *RoomType) (*GeneralResponse, error)
+	DeleteRoomType(context.Context, *DeleteRateRequest) (*GeneralResponse, error)
 	mustEmbedUnimplementedHotelServer()
 }
 
@@ -209,6 +443,60 @@ func (UnimplementedHotelServer) UpdateRoom(context.Context, *UpdateRoomRequest)
 func (UnimplementedHotelServer) DeleteRoom(context.Context, *GetroomRequest) (*GeneralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
 }
+func (UnimplementedHotelServer) SetCancellationPolicy(context.Context, *CancellationPolicy) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCancellationPolicy not implemented")
+}
+func (UnimplementedHotelServer) GetCancellationPolicy(context.Context, *GetHotelRequest) (*CancellationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationPolicy not implemented")
+}
+func (UnimplementedHotelServer) CreateRate(context.Context, *Rate) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRate not implemented")
+}
+func (UnimplementedHotelServer) DeleteRate(context.Context, *DeleteRateRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRate not implemented")
+}
+func (UnimplementedHotelServer) CreateStayDiscount(context.Context, *StayDiscount) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStayDiscount not implemented")
+}
+func (UnimplementedHotelServer) DeleteStayDiscount(context.Context, *DeleteRateRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStayDiscount not implemented")
+}
+func (UnimplementedHotelServer) GetRatePlan(context.Context, *RatePlanRequest) (*RatePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatePlan not implemented")
+}
+func (UnimplementedHotelServer) Quote(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quote not implemented")
+}
+func (UnimplementedHotelServer) SearchHotels(context.Context, *SearchHotelsRequest) (*SearchHotelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHotels not implemented")
+}
+func (UnimplementedHotelServer) NearbyHotels(context.Context, *NearbyHotelsRequest) (*NearbyHotelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearbyHotels not implemented")
+}
+func (UnimplementedHotelServer) CreateAmenity(context.Context, *Amenity) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAmenity not implemented")
+}
+func (UnimplementedHotelServer) ListAmenities(context.Context, *ListAmenitiesRequest) (*ListAmenitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAmenities not implemented")
+}
+func (UnimplementedHotelServer) SetHotelAmenities(context.Context, *SetAmenitiesRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHotelAmenities not implemented")
+}
+func (UnimplementedHotelServer) SetRoomAmenities(context.Context, *SetAmenitiesRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoomAmenities not implemented")
+}
+func (UnimplementedHotelServer) CreateRoomType(context.Context, *RoomType) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoomType not implemented")
+}
+func (UnimplementedHotelServer) GetRoomTypes(context.Context, *GetHotelRequest) (*RoomTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomTypes not implemented")
+}
+func (UnimplementedHotelServer) UpdateRoomType(context.Context, *RoomType) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomType not implemented")
+}
+func (UnimplementedHotelServer) DeleteRoomType(context.Context, *DeleteRateRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoomType not implemented")
+}
 func (UnimplementedHotelServer) mustEmbedUnimplementedHotelServer() {}
 func (UnimplementedHotelServer) testEmbeddedByValue()               {}
 
@@ -410,6 +698,330 @@ func _Hotel_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Hotel_SetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancellationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).SetCancellationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_SetCancellationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).SetCancellationPolicy(ctx, req.(*CancellationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_GetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).GetCancellationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_GetCancellationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).GetCancellationPolicy(ctx, req.(*GetHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_CreateRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).CreateRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_CreateRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).CreateRate(ctx, req.(*Rate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_DeleteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).DeleteRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_DeleteRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).DeleteRate(ctx, req.(*DeleteRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_CreateStayDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StayDiscount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).CreateStayDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_CreateStayDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).CreateStayDiscount(ctx, req.(*StayDiscount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_DeleteStayDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).DeleteStayDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_DeleteStayDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).DeleteStayDiscount(ctx, req.(*DeleteRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_GetRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).GetRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_GetRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).GetRatePlan(ctx, req.(*RatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).Quote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_Quote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).Quote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_SearchHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHotelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).SearchHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_SearchHotels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).SearchHotels(ctx, req.(*SearchHotelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_NearbyHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyHotelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).NearbyHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_NearbyHotels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).NearbyHotels(ctx, req.(*NearbyHotelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_CreateAmenity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Amenity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).CreateAmenity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_CreateAmenity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).CreateAmenity(ctx, req.(*Amenity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_ListAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).ListAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_ListAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).ListAmenities(ctx, req.(*ListAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_SetHotelAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).SetHotelAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_SetHotelAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).SetHotelAmenities(ctx, req.(*SetAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_SetRoomAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).SetRoomAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_SetRoomAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).SetRoomAmenities(ctx, req.(*SetAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_CreateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).CreateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_CreateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).CreateRoomType(ctx, req.(*RoomType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_GetRoomTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).GetRoomTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_GetRoomTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).GetRoomTypes(ctx, req.(*GetHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_UpdateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).UpdateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_UpdateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).UpdateRoomType(ctx, req.(*RoomType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hotel_DeleteRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServer).DeleteRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hotel_DeleteRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServer).DeleteRoomType(ctx, req.(*DeleteRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Hotel_ServiceDesc is the grpc.ServiceDesc for Hotel service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +1069,78 @@ var Hotel_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRoom",
 			Handler:    _Hotel_DeleteRoom_Handler,
 		},
+		{
+			MethodName: "SetCancellationPolicy",
+			Handler:    _Hotel_SetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "GetCancellationPolicy",
+			Handler:    _Hotel_GetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "CreateRate",
+			Handler:    _Hotel_CreateRate_Handler,
+		},
+		{
+			MethodName: "DeleteRate",
+			Handler:    _Hotel_DeleteRate_Handler,
+		},
+		{
+			MethodName: "CreateStayDiscount",
+			Handler:    _Hotel_CreateStayDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteStayDiscount",
+			Handler:    _Hotel_DeleteStayDiscount_Handler,
+		},
+		{
+			MethodName: "GetRatePlan",
+			Handler:    _Hotel_GetRatePlan_Handler,
+		},
+		{
+			MethodName: "Quote",
+			Handler:    _Hotel_Quote_Handler,
+		},
+		{
+			MethodName: "SearchHotels",
+			Handler:    _Hotel_SearchHotels_Handler,
+		},
+		{
+			MethodName: "NearbyHotels",
+			Handler:    _Hotel_NearbyHotels_Handler,
+		},
+		{
+			MethodName: "CreateAmenity",
+			Handler:    _Hotel_CreateAmenity_Handler,
+		},
+		{
+			MethodName: "ListAmenities",
+			Handler:    _Hotel_ListAmenities_Handler,
+		},
+		{
+			MethodName: "SetHotelAmenities",
+			Handler:    _Hotel_SetHotelAmenities_Handler,
+		},
+		{
+			MethodName: "SetRoomAmenities",
+			Handler:    _Hotel_SetRoomAmenities_Handler,
+		},
+		{
+			MethodName: "CreateRoomType",
+			Handler:    _Hotel_CreateRoomType_Handler,
+		},
+		{
+			MethodName: "GetRoomTypes",
+			Handler:    _Hotel_GetRoomTypes_Handler,
+		},
+		{
+			MethodName: "UpdateRoomType",
+			Handler:    _Hotel_UpdateRoomType_Handler,
+		},
+		{
+			MethodName: "DeleteRoomType",
+			Handler:    _Hotel_DeleteRoomType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hotel.proto",
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	email1 "notification-service/utils/email"
 	"notification-service/pkg/proto/booking"
 	"notification-service/pkg/proto/hotel"
 	"sync"
@@ -110,22 +109,6 @@ func (u *WebSocket) RoomDetective(id int, conn *websocket.Conn) {
 				if err := conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("Hotel ID %v\nRoom ID %v\nRoom Type %v\nRoom Price Per Night %v\nRoom Available %v\n", v.HotelId, v.Id, v.RoomType, v.PricePerNight, v.Available))); err != nil {
 					log.Println("Error writing message to WebSocket:", err)
 				}
-				u.WaitingUsers(fmt.Sprintf("Hotel ID %v\nRoom ID %v\nRoom Type %v\nRoom Price Per Night %v\nRoom Available %v\n", v.HotelId, v.Id, v.RoomType, v.PricePerNight, v.Available))
-			}
-		}
-	}
-
-}
-
-func (u *WebSocket) WaitingUsers(body string) {
-	users, err := u.Booking.Getall(u.Ctx, &booking.Request{})
-	if err != nil {
-		log.Println(err)
-	} else {
-		for _, v := range users.Users {
-			fmt.Println(v.UserEmail)
-			if err := email1.Sent(v.UserEmail, body); err != nil {
-				log.Println(err)
 			}
 		}
 	}
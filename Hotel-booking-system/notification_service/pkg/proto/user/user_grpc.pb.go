@@ -19,13 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Register_FullMethodName     = "/User/Register"
-	User_LogIn_FullMethodName        = "/User/LogIn"
-	User_GetUser_FullMethodName      = "/User/GetUser"
-	User_LastInserted_FullMethodName = "/User/LastInserted"
-	User_UpdateUser_FullMethodName   = "/User/UpdateUser"
-	User_LogOut_FullMethodName       = "/User/LogOut"
-	User_DeleteUser_FullMethodName   = "/User/DeleteUser"
+	User_Register_FullMethodName         = "/User/Register"
+	User_LogIn_FullMethodName            = "/User/LogIn"
+	User_GetUser_FullMethodName          = "/User/GetUser"
+	User_LastInserted_FullMethodName     = "/User/LastInserted"
+	User_UpdateUser_FullMethodName       = "/User/UpdateUser"
+	User_LogOut_FullMethodName           = "/User/LogOut"
+	User_DeleteUser_FullMethodName       = "/User/DeleteUser"
+	User_GetCommandStatus_FullMethodName = "/User/GetCommandStatus"
+	User_GrantRole_FullMethodName        = "/User/GrantRole"
+	User_RevokeRole_FullMethodName       = "/User/RevokeRole"
+	User_ResetPassword_FullMethodName    = "/User/ResetPassword"
+	User_LockAccount_FullMethodName      = "/User/LockAccount"
+	User_UnlockAccount_FullMethodName    = "/User/UnlockAccount"
 )
 
 // UserClient is the client API for User service.
@@ -39,6 +45,12 @@ type UserClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*GeneralResponse2, error)
 	LogOut(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GeneralResponse2, error)
 	DeleteUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GeneralResponse2, error)
+	GetCommandStatus(ctx context.Context, in *GetCommandStatusRequest2, opts ...grpc.CallOption) (*CommandStatus2, error)
+	GrantRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*UserRoles, error)
+	RevokeRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*UserRoles, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GeneralResponse2, error)
+	LockAccount(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*AccountLock, error)
+	UnlockAccount(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*AccountLock, error)
 }
 
 type userClient struct {
@@ -119,6 +131,66 @@ func (c *userClient) DeleteUser(ctx context.Context, in *GetUserRequest, opts ..
 	return out, nil
 }
 
+func (c *userClient) GetCommandStatus(ctx context.Context, in *GetCommandStatusRequest2, opts ...grpc.CallOption) (*CommandStatus2, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandStatus2)
+	err := c.cc.Invoke(ctx, User_GetCommandStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GrantRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*UserRoles, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRoles)
+	err := c.cc.Invoke(ctx, User_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*UserRoles, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRoles)
+	err := c.cc.Invoke(ctx, User_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GeneralResponse2, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse2)
+	err := c.cc.Invoke(ctx, User_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LockAccount(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*AccountLock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountLock)
+	err := c.cc.Invoke(ctx, User_LockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnlockAccount(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*AccountLock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountLock)
+	err := c.cc.Invoke(ctx, User_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -130,6 +202,12 @@ type UserServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*GeneralResponse2, error)
 	LogOut(context.Context, *GetUserRequest) (*GeneralResponse2, error)
 	DeleteUser(context.Context, *GetUserRequest) (*GeneralResponse2, error)
+	GetCommandStatus(context.Context, *GetCommandStatusRequest2) (*CommandStatus2, error)
+	GrantRole(context.Context, *RoleRequest) (*UserRoles, error)
+	RevokeRole(context.Context, *RoleRequest) (*UserRoles, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*GeneralResponse2, error)
+	LockAccount(context.Context, *LockRequest) (*AccountLock, error)
+	UnlockAccount(context.Context, *GetUserRequest) (*AccountLock, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -161,6 +239,24 @@ func (UnimplementedUserServer) LogOut(context.Context, *GetUserRequest) (*Genera
 func (UnimplementedUserServer) DeleteUser(context.Context, *GetUserRequest) (*GeneralResponse2, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServer) GetCommandStatus(context.Context, *GetCommandStatusRequest2) (*CommandStatus2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandStatus not implemented")
+}
+func (UnimplementedUserServer) GrantRole(context.Context, *RoleRequest) (*UserRoles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedUserServer) RevokeRole(context.Context, *RoleRequest) (*UserRoles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedUserServer) ResetPassword(context.Context, *ResetPasswordRequest) (*GeneralResponse2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServer) LockAccount(context.Context, *LockRequest) (*AccountLock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockAccount not implemented")
+}
+func (UnimplementedUserServer) UnlockAccount(context.Context, *GetUserRequest) (*AccountLock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -308,6 +404,114 @@ func _User_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetCommandStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandStatusRequest2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetCommandStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetCommandStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetCommandStatus(ctx, req.(*GetCommandStatusRequest2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GrantRole(ctx, req.(*RoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeRole(ctx, req.(*RoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_LockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LockAccount(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnlockAccount(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +547,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _User_DeleteUser_Handler,
 		},
+		{
+			MethodName: "GetCommandStatus",
+			Handler:    _User_GetCommandStatus_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _User_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _User_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _User_ResetPassword_Handler,
+		},
+		{
+			MethodName: "LockAccount",
+			Handler:    _User_LockAccount_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _User_UnlockAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -20,10 +20,16 @@ func main() {
 	}
 
 	s := grpc.NewServer()
-	server := connections.NewGrpc()
+	server, err := connections.NewGrpc()
+	if err != nil {
+		log.Fatal(err)
+	}
 	user.RegisterUserServer(s, server)
 	reflection.Register(s)
-	a := connections.NewConsumer()
+	a, err := connections.NewConsumer()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	go func() {
 		a.Consumer()
@@ -77,11 +77,15 @@ func NewGrpc() (*grpcmet.Service, error) {
 	return &grpcmet.Service{S: a}, nil
 }
 
-func NewConsumer() (*cons.Consumer1, error) {
+func NewConsumer() (*cons.Consumer17, error) {
 	a, err := NewGrpc()
 	if err != nil {
 		return nil, err
 	}
+	s, err := NewService()
+	if err != nil {
+		return nil, err
+	}
 	ctx := context.Background()
-	return &cons.Consumer1{C: a, Ctx: ctx}, nil
+	return &cons.Consumer17{C: a, S: s, Ctx: ctx}, nil
 }
@@ -13,7 +13,17 @@ type User interface {
 	LastInserted(ctx context.Context, req *models.LastInsertedUser) (*models.GetUserResponse, error)
 	UpdateUser(ctx context.Context, req *models.UpdateUserRequest) (*models.GeneralResponse, error)
 	LogOut(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error)
-	DeletUser(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error)
+	DeleteUser(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error)
+	ResetPassword(ctx context.Context, req *models.ResetPasswordRequest) (*models.GeneralResponse, error)
+	LockAccount(ctx context.Context, req *models.LockRequest) (*models.AccountLock, error)
+	UnlockAccount(ctx context.Context, req *models.GetUserRequest) (*models.AccountLock, error)
+	GrantRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error)
+	RevokeRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error)
+	Roles(ctx context.Context, req *models.GetUserRequest) (*models.UserRoles, error)
+	SaveCommand(ctx context.Context, req *models.Command) (*models.GeneralResponse, error)
+	GetCommand(ctx context.Context, req *models.GetCommandRequest) (*models.Command, error)
+	SaveProcessedKey(ctx context.Context, req *models.ProcessedKey) (*models.GeneralResponse, error)
+	GetProcessedKey(ctx context.Context, key string) (*models.ProcessedKey, error)
 }
 
 type Adjust interface {
@@ -24,4 +34,10 @@ type Adjust interface {
 	Update(ctx context.Context, req *user.UpdateUserRequest) (*user.GeneralResponse, error)
 	Logout(ctx context.Context, req *user.GetUserRequest) (*user.GeneralResponse, error)
 	Delete(ctx context.Context, req *user.GetUserRequest) (*user.GeneralResponse, error)
+	ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.GeneralResponse, error)
+	LockAccount(ctx context.Context, req *user.LockRequest) (*user.AccountLock, error)
+	UnlockAccount(ctx context.Context, req *user.GetUserRequest) (*user.AccountLock, error)
+	GrantRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error)
+	RevokeRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error)
+	GetCommandStatus(ctx context.Context, req *user.GetCommandStatusRequest) (*user.CommandStatus, error)
 }
@@ -39,8 +39,48 @@ func (u *Service) LogOut(ctx context.Context, req *models.GetUserRequest) (*mode
 	return u.D.LogOut(ctx, req)
 }
 
-func (u *Service) DeletUser(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error) {
-	return u.D.DeletUser(ctx, req)
+func (u *Service) DeleteUser(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error) {
+	return u.D.DeleteUser(ctx, req)
+}
+
+func (u *Service) ResetPassword(ctx context.Context, req *models.ResetPasswordRequest) (*models.GeneralResponse, error) {
+	return u.D.ResetPassword(ctx, req)
+}
+
+func (u *Service) LockAccount(ctx context.Context, req *models.LockRequest) (*models.AccountLock, error) {
+	return u.D.LockAccount(ctx, req)
+}
+
+func (u *Service) UnlockAccount(ctx context.Context, req *models.GetUserRequest) (*models.AccountLock, error) {
+	return u.D.UnlockAccount(ctx, req)
+}
+
+func (u *Service) GrantRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error) {
+	return u.D.GrantRole(ctx, req)
+}
+
+func (u *Service) RevokeRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error) {
+	return u.D.RevokeRole(ctx, req)
+}
+
+func (u *Service) Roles(ctx context.Context, req *models.GetUserRequest) (*models.UserRoles, error) {
+	return u.D.Roles(ctx, req)
+}
+
+func (u *Service) SaveCommand(ctx context.Context, req *models.Command) (*models.GeneralResponse, error) {
+	return u.D.SaveCommand(ctx, req)
+}
+
+func (u *Service) GetCommand(ctx context.Context, req *models.GetCommandRequest) (*models.Command, error) {
+	return u.D.GetCommand(ctx, req)
+}
+
+func (u *Service) SaveProcessedKey(ctx context.Context, req *models.ProcessedKey) (*models.GeneralResponse, error) {
+	return u.D.SaveProcessedKey(ctx, req)
+}
+
+func (u *Service) GetProcessedKey(ctx context.Context, key string) (*models.ProcessedKey, error) {
+	return u.D.GetProcessedKey(ctx, key)
 }
 
 func (u *Adjust) AddUser(ctx context.Context, req *user.RegisterUserRequest) (*user.GeneralResponse, error) {
@@ -68,4 +108,28 @@ func (u *Adjust) Delete(ctx context.Context, req *user.GetUserRequest) (*user.Ge
 }
 func (u *Adjust) LogIn(ctx context.Context, req *user.LogInRequest) (*user.LogInResposne, error){
 	return u.A.LogIn(ctx,req)
-}
\ No newline at end of file
+}
+
+func (u *Adjust) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.GeneralResponse, error) {
+	return u.A.ResetPassword(ctx, req)
+}
+
+func (u *Adjust) LockAccount(ctx context.Context, req *user.LockRequest) (*user.AccountLock, error) {
+	return u.A.LockAccount(ctx, req)
+}
+
+func (u *Adjust) UnlockAccount(ctx context.Context, req *user.GetUserRequest) (*user.AccountLock, error) {
+	return u.A.UnlockAccount(ctx, req)
+}
+
+func (u *Adjust) GrantRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	return u.A.GrantRole(ctx, req)
+}
+
+func (u *Adjust) RevokeRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	return u.A.RevokeRole(ctx, req)
+}
+
+func (u *Adjust) GetCommandStatus(ctx context.Context, req *user.GetCommandStatusRequest) (*user.CommandStatus, error) {
+	return u.A.GetCommandStatus(ctx, req)
+}
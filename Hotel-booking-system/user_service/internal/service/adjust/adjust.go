@@ -2,10 +2,14 @@ package adjustservice
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 	"user-service/internal/interface/service"
 	"user-service/models"
 	"user-service/pkg/proto/user"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Adjust struct {
@@ -23,22 +27,23 @@ func (u *Adjust) LogIn(ctx context.Context, req *user.LogInRequest) (*user.LogIn
 		return nil, err
 	}
 
-	return &user.LogInResposne{Status: res.Status}, nil
+	return &user.LogInResposne{Status: res.Status, Id: res.ID, Roles: res.Roles, HotelIds: res.HotelIDs}, nil
 }
 
 func (u *Adjust) AddUser(ctx context.Context, req *user.RegisterUserRequest) (*user.GeneralResponse, error) {
 	var newreq = models.RegisterUserRequest{
-		Username: req.Username,
-		Age:      req.Age,
-		Email:    req.Email,
-		Password: req.Password,
+		Username:     req.Username,
+		Age:          req.Age,
+		Email:        req.Email,
+		Password:     req.Password,
+		PasswordHash: req.PasswordHash,
 	}
 	res, err := u.S.CreateUser(ctx, &newreq)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &user.GeneralResponse{Message: res.Message}, nil
+	return &user.GeneralResponse{Message: res.Message, Id: res.ID}, nil
 }
 
 func (u *Adjust) GetUser(ctx context.Context, req *user.GetUserRequest) (*user.GetUserResponse, error) {
@@ -73,7 +78,7 @@ func (u *Adjust) Update(ctx context.Context, req *user.UpdateUserRequest) (*user
 		log.Println(err)
 		return nil, err
 	}
-	return &user.GeneralResponse{Message: res.Message}, nil
+	return &user.GeneralResponse{Message: res.Message, Id: res.ID}, nil
 }
 
 func (u *Adjust) Logout(ctx context.Context, req *user.GetUserRequest) (*user.GeneralResponse, error) {
@@ -82,14 +87,122 @@ func (u *Adjust) Logout(ctx context.Context, req *user.GetUserRequest) (*user.Ge
 		log.Println(err)
 		return nil, err
 	}
-	return &user.GeneralResponse{Message: res.Message}, nil
+	return &user.GeneralResponse{Message: res.Message, Id: res.ID}, nil
 }
 
 func (u *Adjust) Delete(ctx context.Context, req *user.GetUserRequest) (*user.GeneralResponse, error) {
-	res, err := u.S.DeletUser(ctx, &models.GetUserRequest{ID: req.Id})
+	res, err := u.S.DeleteUser(ctx, &models.GetUserRequest{ID: req.Id})
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return &user.GeneralResponse{Message: res.Message}, nil
+	return &user.GeneralResponse{Message: res.Message, Id: res.ID}, nil
+}
+
+// minPasswordLength is the shortest password a reset accepts.
+const minPasswordLength = 8
+
+func (u *Adjust) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.GeneralResponse, error) {
+	if len(req.Password) < minPasswordLength {
+		return nil, fmt.Errorf("%w: use at least %d characters", models.ErrWeakPassword, minPasswordLength)
+	}
+	res, err := u.S.ResetPassword(ctx, &models.ResetPasswordRequest{Email: req.Email, Password: req.Password})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &user.GeneralResponse{Message: res.Message, Id: res.ID}, nil
+}
+
+// LockAccount locks an account until req.Until, which must be in the future.
+func (u *Adjust) LockAccount(ctx context.Context, req *user.LockRequest) (*user.AccountLock, error) {
+	if req.Until == nil || !req.Until.AsTime().After(time.Now()) {
+		return nil, fmt.Errorf("%w: lock needs a future until", models.ErrInvalidLock)
+	}
+	res, err := u.S.LockAccount(ctx, &models.LockRequest{Email: req.Email, Until: req.Until.AsTime()})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return accountLock(res), nil
+}
+
+func (u *Adjust) UnlockAccount(ctx context.Context, req *user.GetUserRequest) (*user.AccountLock, error) {
+	res, err := u.S.UnlockAccount(ctx, &models.GetUserRequest{ID: req.Id})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return accountLock(res), nil
+}
+
+func accountLock(res *models.AccountLock) *user.AccountLock {
+	lock := &user.AccountLock{Id: res.ID, Email: res.Email}
+	if !res.LockedUntil.IsZero() {
+		lock.LockedUntil = timestamppb.New(res.LockedUntil)
+	}
+	return lock
+}
+
+// GrantRole gives a user a role; the manager role needs the hotel it is for.
+func (u *Adjust) GrantRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	if err := checkRole(req); err != nil {
+		return nil, err
+	}
+	if req.Role == models.RoleManager && req.HotelId == 0 {
+		return nil, fmt.Errorf("%w: a manager needs a hotel_id", models.ErrInvalidRole)
+	}
+	res, err := u.S.GrantRole(ctx, &models.RoleRequest{UserID: req.UserId, Role: req.Role, HotelID: req.HotelId})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return userRoles(res), nil
+}
+
+func (u *Adjust) RevokeRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	if err := checkRole(req); err != nil {
+		return nil, err
+	}
+	res, err := u.S.RevokeRole(ctx, &models.RoleRequest{UserID: req.UserId, Role: req.Role, HotelID: req.HotelId})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return userRoles(res), nil
+}
+
+// checkRole accepts the known roles, with a hotel only for managers.
+func checkRole(req *user.RoleRequest) error {
+	switch req.Role {
+	case models.RoleGuest, models.RoleManager, models.RoleAdmin:
+	default:
+		return fmt.Errorf("%w: role must be %q, %q or %q", models.ErrInvalidRole, models.RoleGuest, models.RoleManager, models.RoleAdmin)
+	}
+	if req.HotelId != 0 && req.Role != models.RoleManager {
+		return fmt.Errorf("%w: only managers are assigned hotels", models.ErrInvalidRole)
+	}
+	return nil
+}
+
+func userRoles(res *models.UserRoles) *user.UserRoles {
+	return &user.UserRoles{UserId: res.UserID, Roles: res.Roles, HotelIds: res.HotelIDs}
+}
+
+func (u *Adjust) GetCommandStatus(ctx context.Context, req *user.GetCommandStatusRequest) (*user.CommandStatus, error) {
+	res, err := u.S.GetCommand(ctx, &models.GetCommandRequest{ID: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &user.CommandStatus{
+		Id:        res.ID,
+		Kind:      res.Kind,
+		UserId:    res.UserID,
+		Status:    res.Status,
+		EntityId:  res.EntityID,
+		Message:   res.Message,
+		Error:     res.Error,
+		CreatedAt: timestamppb.New(res.CreatedAt),
+		UpdatedAt: timestamppb.New(res.UpdatedAt),
+	}, nil
 }
@@ -2,9 +2,14 @@ package grpcmethods
 
 import (
 	"context"
+	"errors"
 	"log"
 	"user-service/internal/interface/service"
+	"user-service/models"
 	"user-service/pkg/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service struct {
@@ -25,6 +30,13 @@ func (u *Service) LogIn(ctx context.Context, req *user.LogInRequest) (*user.LogI
 	res, err := u.S.LogIn(ctx, req)
 	if err != nil {
 		log.Println(err)
+		// The gateway counts Unauthenticated answers as failed logins
+		if errors.Is(err, models.ErrWrongPassword) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, models.ErrAccountLocked) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, err
 	}
 	return res, nil
@@ -74,3 +86,87 @@ func (u *Service) DeleteUser(ctx context.Context, req *user.GetUserRequest) (*us
 	}
 	return res, nil
 }
+
+func (u *Service) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.GeneralResponse, error) {
+	res, err := u.S.ResetPassword(ctx, req)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, models.ErrWeakPassword) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *Service) LockAccount(ctx context.Context, req *user.LockRequest) (*user.AccountLock, error) {
+	res, err := u.S.LockAccount(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, lockError(err)
+	}
+	return res, nil
+}
+
+func (u *Service) UnlockAccount(ctx context.Context, req *user.GetUserRequest) (*user.AccountLock, error) {
+	res, err := u.S.UnlockAccount(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, lockError(err)
+	}
+	return res, nil
+}
+
+func lockError(err error) error {
+	if errors.Is(err, models.ErrInvalidLock) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, models.ErrUserNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (u *Service) GrantRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	res, err := u.S.GrantRole(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, roleError(err)
+	}
+	return res, nil
+}
+
+func (u *Service) RevokeRole(ctx context.Context, req *user.RoleRequest) (*user.UserRoles, error) {
+	res, err := u.S.RevokeRole(ctx, req)
+	if err != nil {
+		log.Println(err)
+		return nil, roleError(err)
+	}
+	return res, nil
+}
+
+func roleError(err error) error {
+	if errors.Is(err, models.ErrInvalidRole) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, models.ErrUserNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (u *Service) GetCommandStatus(ctx context.Context, req *user.GetCommandStatusRequest) (*user.CommandStatus, error) {
+	res, err := u.S.GetCommandStatus(ctx, req)
+	if err != nil {
+		log.Println(err)
+		// NotFound lets the gateway ask the other services for the command
+		if errors.Is(err, models.ErrCommandNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
@@ -1,14 +1,24 @@
 package models
 
+import (
+	"errors"
+	"time"
+)
+
+// RegisterUserRequest carries either the password or, from the gateway's
+// pending signups, its bcrypt hash.
 type RegisterUserRequest struct {
-	Username string `json:"username"`
-	Age      int32  `json:"age"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Username     string `json:"username"`
+	Age          int32  `json:"age"`
+	Email        string `json:"email"`
+	Password     string `json:"password"`
+	PasswordHash string `json:"password_hash,omitempty"`
 }
 
 type GeneralResponse struct {
 	Message string `json:"message"`
+	// ID is the user the request created or changed.
+	ID int32 `json:"id,omitempty"`
 }
 
 type VerifyRequest struct {
@@ -25,8 +35,50 @@ type GetUserRequest struct {
 	ID int32 `json:"id"`
 }
 
+const (
+	RoleGuest   = "guest"
+	RoleManager = "manager"
+	RoleAdmin   = "admin"
+)
+
+// LogInResponse tells who logged in, for the claims of their token.
 type LogInResponse struct {
-	Status bool `json:"status"`
+	Status   bool     `json:"status"`
+	ID       int32    `json:"id"`
+	Roles    []string `json:"roles"`
+	HotelIDs []int32  `json:"hotel_ids"`
+}
+
+type ResetPasswordRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LockRequest struct {
+	Email string    `json:"email"`
+	Until time.Time `json:"until"`
+}
+
+// AccountLock is the lock of an account, LockedUntil is zero once unlocked.
+type AccountLock struct {
+	ID          int32     `json:"id"`
+	Email       string    `json:"email"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+// RoleRequest grants or revokes a role. HotelID goes with the manager role:
+// granting assigns the hotel, revoking with it takes back just that hotel.
+type RoleRequest struct {
+	UserID  int32  `json:"user_id"`
+	Role    string `json:"role"`
+	HotelID int32  `json:"hotel_id"`
+}
+
+// UserRoles is what a user may do and, for a manager, the hotels they run.
+type UserRoles struct {
+	UserID   int32    `json:"user_id"`
+	Roles    []string `json:"roles"`
+	HotelIDs []int32  `json:"hotel_ids"`
 }
 
 type LastInsertedUser struct{}
@@ -46,3 +98,46 @@ type UpdateUserRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
+
+const (
+	CommandSucceeded = "succeeded"
+	CommandFailed    = "failed"
+)
+
+// Command is the result of an asynchronous write taken from Kafka.
+type Command struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	UserID    int32     `json:"user_id"`
+	Status    string    `json:"status"`
+	EntityID  int32     `json:"entity_id"`
+	Message   string    `json:"message"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetCommandRequest struct {
+	ID string `json:"id"`
+}
+
+// ProcessedKey is the result of the first successful command sent with an
+// idempotency key; repeats of the command get this result back.
+type ProcessedKey struct {
+	Key       string `json:"key"`
+	Kind      string `json:"kind"`
+	CommandID string `json:"command_id"`
+	EntityID  int32  `json:"entity_id"`
+	Message   string `json:"message"`
+}
+
+var (
+	ErrCommandNotFound = errors.New("command not found or not processed yet")
+	ErrKeyNotProcessed = errors.New("idempotency key was not processed yet")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrInvalidRole     = errors.New("invalid role")
+	ErrWeakPassword    = errors.New("password is too weak")
+	ErrWrongPassword   = errors.New("the password is not correct 🤨")
+	ErrAccountLocked   = errors.New("the account is locked after too many failed logins")
+	ErrInvalidLock     = errors.New("invalid account lock")
+)
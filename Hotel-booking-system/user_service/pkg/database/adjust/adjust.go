@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 	"user-service/models"
 	db "user-service/pkg/database/sql"
 	"user-service/pkg/proto/notification"
 
+	"github.com/lib/pq"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -26,24 +28,50 @@ func (u *Database) LogIn(ctx context.Context, req *models.LogInRequest) (*models
 		return nil, err
 	}
 
-	var password string
-	if err := u.Db.QueryRow(query, args...).Scan(&password); err != nil {
+	var (
+		res         models.LogInResponse
+		password    string
+		lockedUntil sql.NullTime
+	)
+	if err := u.Db.QueryRow(query, args...).Scan(&res.ID, &password, &lockedUntil); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Unknown emails fail like wrong passwords, not to tell which exist
+			return nil, models.ErrWrongPassword
+		}
 		log.Println("Error retrieving password:", err)
 		return nil, err
 	}
+	if lockedUntil.Valid && lockedUntil.Time.After(time.Now()) {
+		return nil, fmt.Errorf("%w until %s", models.ErrAccountLocked, lockedUntil.Time.UTC().Format(time.RFC3339))
+	}
 
 	if u.ComparePassword(password, req.Password) {
-		_, err := u.N.Email(ctx, &notification.EmailSend{Email: req.Email, Message: "You are logged in again, you have already logged in before 😉"})
+		roles, err := u.Roles(ctx, &models.GetUserRequest{ID: res.ID})
+		if err != nil {
+			return nil, err
+		}
+		res.Roles, res.HotelIDs = roles.Roles, roles.HotelIDs
+
+		_, err = u.N.Email(ctx, &notification.EmailSend{Email: req.Email, Message: "You are logged in again, you have already logged in before 😉"})
 		if err != nil {
 			log.Println("Error sending email notification:", err)
 		}
-		return &models.LogInResponse{Status: true}, nil
+		res.Status = true
+		return &res, nil
 	}
-	return nil, errors.New("the password is not correct 🤨")
+	return nil, models.ErrWrongPassword
 }
 
 func (u *Database) CreateUser(ctx context.Context, req *models.RegisterUserRequest) (*models.GeneralResponse, error) {
-	req.Password = u.Hashing(req.Password)
+	if req.PasswordHash != "" {
+		if _, err := bcrypt.Cost([]byte(req.PasswordHash)); err != nil {
+			log.Println("Error checking password hash:", err)
+			return nil, err
+		}
+		req.Password = req.PasswordHash
+	} else {
+		req.Password = u.Hashing(req.Password)
+	}
 	query, args, err := db.Create(req)
 	if err != nil {
 		log.Println("Error building user creation query:", err)
@@ -64,7 +92,7 @@ func (u *Database) CreateUser(ctx context.Context, req *models.RegisterUserReque
 	if err != nil {
 		log.Println("Error sending notification:", err)
 	}
-	return &models.GeneralResponse{Message: fmt.Sprintf("Successfully created ID %v 👍", id)}, nil
+	return &models.GeneralResponse{Message: fmt.Sprintf("Successfully created ID %v 👍", id), ID: int32(id)}, nil
 }
 
 func (u *Database) GetUser(ctx context.Context, req *models.GetUserRequest) (*models.GetUserResponse, error) {
@@ -114,7 +142,7 @@ func (u *Database) UpdateUser(ctx context.Context, req *models.UpdateUserRequest
 	if _, err = u.N.Notification(ctx, &notification.ProduceMessage{UserId: int32(id), Message: fmt.Sprintf("Your account has been updated successfully with ID %v", id)}); err != nil {
 		log.Println("Error sending update notification:", err)
 	}
-	return &models.GeneralResponse{Message: fmt.Sprintf("Your account has been updated with ID %v 🤓", id)}, nil
+	return &models.GeneralResponse{Message: fmt.Sprintf("Your account has been updated with ID %v 🤓", id), ID: int32(id)}, nil
 }
 
 func (u *Database) LogOut(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error) {
@@ -132,7 +160,75 @@ func (u *Database) LogOut(ctx context.Context, req *models.GetUserRequest) (*mod
 	if _, err = u.N.Notification(ctx, &notification.ProduceMessage{UserId: int32(req.ID), Message: "You have successfully logged out, you can log in again! 👍"}); err != nil {
 		log.Println("Error sending logout notification:", err)
 	}
-	return &models.GeneralResponse{Message: "Exit... 👉"}, nil
+	return &models.GeneralResponse{Message: "Exit... 👉", ID: req.ID}, nil
+}
+
+func (u *Database) ResetPassword(ctx context.Context, req *models.ResetPasswordRequest) (*models.GeneralResponse, error) {
+	if req.Password = u.Hashing(req.Password); req.Password == "" {
+		return nil, errors.New("could not hash the password")
+	}
+	query, args, err := db.ResetPassword(req)
+	if err != nil {
+		log.Println("Error building reset password query:", err)
+		return nil, err
+	}
+
+	var id int32
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		log.Println("Error resetting password:", err)
+		return nil, err
+	}
+
+	if _, err = u.N.Email(ctx, &notification.EmailSend{Email: req.Email, Message: "Your password has been reset. If it wasn't you, reset it again right away 🔐"}); err != nil {
+		log.Println("Error sending password reset email:", err)
+	}
+	return &models.GeneralResponse{Message: "Your password has been reset", ID: id}, nil
+}
+
+// LockAccount locks an account after repeated failed logins and warns its
+// owner by email.
+func (u *Database) LockAccount(ctx context.Context, req *models.LockRequest) (*models.AccountLock, error) {
+	query, args, err := db.LockAccount(req)
+	if err != nil {
+		log.Println("Error building lock account query:", err)
+		return nil, err
+	}
+
+	res := models.AccountLock{LockedUntil: req.Until}
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&res.ID, &res.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		log.Println("Error locking account:", err)
+		return nil, err
+	}
+
+	message := fmt.Sprintf("There were too many failed logins to your account, so it is locked until %s. If it wasn't you, reset your password 🔐", req.Until.UTC().Format(time.RFC1123))
+	if _, err = u.N.Email(ctx, &notification.EmailSend{Email: res.Email, Message: message}); err != nil {
+		log.Println("Error sending account locked email:", err)
+	}
+	return &res, nil
+}
+
+func (u *Database) UnlockAccount(ctx context.Context, req *models.GetUserRequest) (*models.AccountLock, error) {
+	query, args, err := db.UnlockAccount(req)
+	if err != nil {
+		log.Println("Error building unlock account query:", err)
+		return nil, err
+	}
+
+	var res models.AccountLock
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&res.ID, &res.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		log.Println("Error unlocking account:", err)
+		return nil, err
+	}
+	return &res, nil
 }
 
 func (u *Database) DeleteUser(ctx context.Context, req *models.GetUserRequest) (*models.GeneralResponse, error) {
@@ -150,7 +246,172 @@ func (u *Database) DeleteUser(ctx context.Context, req *models.GetUserRequest) (
 	if _, err = u.N.Notification(ctx, &notification.ProduceMessage{UserId: int32(req.ID), Message: "You have successfully deleted your account"}); err != nil {
 		log.Println("Error sending delete notification:", err)
 	}
-	return &models.GeneralResponse{Message: "Deleting... ☠️"}, nil
+	return &models.GeneralResponse{Message: "Deleting... ☠️", ID: req.ID}, nil
+}
+
+// GrantRole gives a user a role and, with req.HotelID, a hotel to manage.
+func (u *Database) GrantRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error) {
+	tx, err := u.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting grant role transaction:", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query, args, err := db.GrantRole(req)
+	if err != nil {
+		log.Println("Error building grant role query:", err)
+		return nil, err
+	}
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&req.UserID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		log.Println("Error granting role:", err)
+		return nil, err
+	}
+	if req.HotelID != 0 {
+		query, args, err := db.AddManagedHotel(req)
+		if err != nil {
+			log.Println("Error building add managed hotel query:", err)
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			log.Println("Error adding managed hotel:", err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Println("Error committing grant role:", err)
+		return nil, err
+	}
+	return u.Roles(ctx, &models.GetUserRequest{ID: req.UserID})
+}
+
+// RevokeRole takes a role away from a user. A manager role revoked with
+// req.HotelID only loses that hotel; without it, all of them go with the role.
+func (u *Database) RevokeRole(ctx context.Context, req *models.RoleRequest) (*models.UserRoles, error) {
+	tx, err := u.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting revoke role transaction:", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if req.HotelID == 0 {
+		query, args, err := db.RevokeRole(req)
+		if err != nil {
+			log.Println("Error building revoke role query:", err)
+			return nil, err
+		}
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(&req.UserID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, models.ErrUserNotFound
+			}
+			log.Println("Error revoking role:", err)
+			return nil, err
+		}
+	}
+	if req.Role == models.RoleManager {
+		query, args, err := db.RemoveManagedHotels(req)
+		if err != nil {
+			log.Println("Error building remove managed hotels query:", err)
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			log.Println("Error removing managed hotels:", err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Println("Error committing revoke role:", err)
+		return nil, err
+	}
+	return u.Roles(ctx, &models.GetUserRequest{ID: req.UserID})
+}
+
+func (u *Database) Roles(ctx context.Context, req *models.GetUserRequest) (*models.UserRoles, error) {
+	query, args, err := db.Roles(req)
+	if err != nil {
+		log.Println("Error building roles query:", err)
+		return nil, err
+	}
+
+	res := models.UserRoles{UserID: req.ID}
+	var hotelIDs pq.Int32Array
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(pq.Array(&res.Roles), &hotelIDs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		log.Println("Error scanning roles:", err)
+		return nil, err
+	}
+	res.HotelIDs = hotelIDs
+	return &res, nil
+}
+
+func (u *Database) SaveCommand(ctx context.Context, req *models.Command) (*models.GeneralResponse, error) {
+	query, args, err := db.SaveCommand(req)
+	if err != nil {
+		log.Println("Error building save command query:", err)
+		return nil, err
+	}
+
+	if _, err = u.Db.ExecContext(ctx, query, args...); err != nil {
+		log.Println("Error saving command result:", err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: fmt.Sprintf("Command %s %s", req.ID, req.Status)}, nil
+}
+
+func (u *Database) GetCommand(ctx context.Context, req *models.GetCommandRequest) (*models.Command, error) {
+	query, args, err := db.GetCommand(req)
+	if err != nil {
+		log.Println("Error building get command query:", err)
+		return nil, err
+	}
+
+	var res models.Command
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&res.ID, &res.Kind, &res.UserID, &res.Status, &res.EntityID, &res.Message, &res.Error, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrCommandNotFound
+		}
+		log.Println("Error scanning command:", err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *Database) SaveProcessedKey(ctx context.Context, req *models.ProcessedKey) (*models.GeneralResponse, error) {
+	query, args, err := db.SaveProcessedKey(req)
+	if err != nil {
+		log.Println("Error building save processed key query:", err)
+		return nil, err
+	}
+
+	if _, err = u.Db.ExecContext(ctx, query, args...); err != nil {
+		log.Println("Error saving processed key:", err)
+		return nil, err
+	}
+	return &models.GeneralResponse{Message: req.Key}, nil
+}
+
+func (u *Database) GetProcessedKey(ctx context.Context, key string) (*models.ProcessedKey, error) {
+	query, args, err := db.GetProcessedKey(key)
+	if err != nil {
+		log.Println("Error building get processed key query:", err)
+		return nil, err
+	}
+
+	var res models.ProcessedKey
+	if err := u.Db.QueryRowContext(ctx, query, args...).Scan(&res.Key, &res.Kind, &res.CommandID, &res.EntityID, &res.Message); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrKeyNotProcessed
+		}
+		log.Println("Error scanning processed key:", err)
+		return nil, err
+	}
+	return &res, nil
 }
 
 func (u *Database) ComparePassword(hashed, password string) bool {
@@ -162,7 +423,7 @@ func (u *Database) ComparePassword(hashed, password string) bool {
 }
 
 func (u *Database) Hashing(password string) string {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		log.Println("Error hashing password:", err)
 		return ""
@@ -65,8 +65,54 @@ func Update(req *models.UpdateUserRequest) (string, []interface{}, error) {
 	return query, args, nil
 }
 
+// ResetPassword sets the password of the user with the email. Proving the
+// email also lifts a lock after failed logins.
+func ResetPassword(req *models.ResetPasswordRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("users").
+		Set("password", req.Password).
+		Set("locked_until", nil).
+		Where(squirrel.Eq{"email": req.Email}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// LockAccount locks the account of the email until req.Until.
+func LockAccount(req *models.LockRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("users").
+		Set("locked_until", req.Until).
+		Where(squirrel.Eq{"email": req.Email}).
+		Suffix("RETURNING id, email").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func UnlockAccount(req *models.GetUserRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("users").
+		Set("locked_until", nil).
+		Where(squirrel.Eq{"id": req.ID}).
+		Suffix("RETURNING id, email").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
 func LogIn(req *models.LogInRequest) (string, []interface{}, error) {
-	query, args, err := squirrel.Select("password").
+	query, args, err := squirrel.Select("id", "password", "locked_until").
 		From("users").
 		Where(squirrel.Eq{"email": req.Email}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -78,6 +124,81 @@ func LogIn(req *models.LogInRequest) (string, []interface{}, error) {
 	return query, args, nil
 }
 
+// GrantRole adds a role the user doesn't have yet.
+func GrantRole(req *models.RoleRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("users").
+		Set("roles", squirrel.Expr("CASE WHEN ? = ANY(roles) THEN roles ELSE array_append(roles, ?) END", req.Role, req.Role)).
+		Where(squirrel.Eq{"id": req.UserID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func RevokeRole(req *models.RoleRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Update("users").
+		Set("roles", squirrel.Expr("array_remove(roles, ?)", req.Role)).
+		Where(squirrel.Eq{"id": req.UserID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func AddManagedHotel(req *models.RoleRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("hotel_managers").
+		Columns("user_id", "hotel_id").
+		Values(req.UserID, req.HotelID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// RemoveManagedHotels takes back req.HotelID, or every hotel without it.
+func RemoveManagedHotels(req *models.RoleRequest) (string, []interface{}, error) {
+	where := squirrel.Eq{"user_id": req.UserID}
+	if req.HotelID != 0 {
+		where["hotel_id"] = req.HotelID
+	}
+	query, args, err := squirrel.Delete("hotel_managers").
+		Where(where).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func Roles(req *models.GetUserRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("u.roles", "COALESCE(array_agg(m.hotel_id ORDER BY m.hotel_id) FILTER (WHERE m.hotel_id IS NOT NULL), '{}')").
+		From("users u").
+		LeftJoin("hotel_managers m ON m.user_id = u.id").
+		Where(squirrel.Eq{"u.id": req.ID}).
+		GroupBy("u.id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
 func LogOut(req *models.GetUserRequest) (string, []interface{}, error) {
 	query, args, err := squirrel.Update("users").
 		SetMap(map[string]interface{}{
@@ -103,3 +224,60 @@ func Delete(req *models.GetUserRequest) (string, []interface{}, error) {
 	}
 	return query, args, nil
 }
+
+// SaveCommand records the result of a command. A command redelivered by
+// Kafka overwrites its earlier result.
+func SaveCommand(req *models.Command) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("commands").
+		Columns("id", "kind", "user_id", "status", "entity_id", "message", "error").
+		Values(req.ID, req.Kind, req.UserID, req.Status, req.EntityID, req.Message, req.Error).
+		Suffix("ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, entity_id = EXCLUDED.entity_id, message = EXCLUDED.message, error = EXCLUDED.error, updated_at = NOW()").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetCommand(req *models.GetCommandRequest) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("id", "kind", "user_id", "status", "entity_id", "message", "error", "created_at", "updated_at").
+		From("commands").
+		Where(squirrel.Eq{"id": req.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// SaveProcessedKey keeps the first result stored for a key.
+func SaveProcessedKey(req *models.ProcessedKey) (string, []interface{}, error) {
+	query, args, err := squirrel.Insert("processed_keys").
+		Columns("key", "kind", "command_id", "entity_id", "message").
+		Values(req.Key, req.Kind, req.CommandID, req.EntityID, req.Message).
+		Suffix("ON CONFLICT (key) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+func GetProcessedKey(key string) (string, []interface{}, error) {
+	query, args, err := squirrel.Select("key", "kind", "command_id", "entity_id", "message").
+		From("processed_keys").
+		Where(squirrel.Eq{"key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		log.Println(err)
+		return "", nil, err
+	}
+	return query, args, nil
+}
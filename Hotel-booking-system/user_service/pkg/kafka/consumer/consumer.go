@@ -3,16 +3,33 @@ package dconsumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"strconv"
+	"user-service/internal/interface/service"
+	grpcmethods "user-service/internal/service/methods"
 	"user-service/models"
 	"user-service/pkg/proto/user"
-	grpcmethods "user-service/internal/service/methods"
 
 	"github.com/twmb/franz-go/pkg/kgo"
 )
 
+const (
+	// CommandHeader carries the command id set by the gateway, so the result
+	// can be looked up under the id the caller was given.
+	CommandHeader = "command_id"
+	// IdempotencyHeader carries the client's Idempotency-Key, so a request
+	// that was already processed is not run again.
+	IdempotencyHeader = "idempotency_key"
+	// UserHeader carries the id of the user who issued the command, so only
+	// they and admins can read its result.
+	UserHeader = "user_id"
+)
+
 type Consumer17 struct {
 	C   *grpcmethods.Service
+	S   *service.Service
 	Ctx context.Context
 }
 
@@ -42,58 +59,124 @@ func (u *Consumer17) Consumer() {
 }
 
 func (u *Consumer17) Adjust(record *kgo.Record) error {
-	switch string(record.Key) {
-	case "create":
-		if err := u.Create(record.Value); err != nil {
-			log.Println(err)
+	key := header(record, IdempotencyHeader)
+	if key != "" {
+		done, err := u.S.GetProcessedKey(u.Ctx, key)
+		if err == nil {
+			// A retried or redelivered command gets the first result back
+			// instead of running again
+			if done.Kind != string(record.Key) {
+				err = fmt.Errorf("idempotency key %q was already used for a %s command", key, done.Kind)
+				u.record(record, nil, err)
+				return err
+			}
+			u.record(record, &user.GeneralResponse{Id: done.EntityID, Message: done.Message}, nil)
 			return nil
 		}
-	case "update":
-		if err := u.Update(record.Value); err != nil {
-			log.Println(err)
+		if !errors.Is(err, models.ErrKeyNotProcessed) {
+			// Without the lookup a duplicate cannot be ruled out
+			u.record(record, nil, err)
 			return err
 		}
+	}
+
+	var (
+		res *user.GeneralResponse
+		err error
+	)
+	switch string(record.Key) {
+	case "create":
+		res, err = u.Create(record.Value)
+	case "update":
+		res, err = u.Update(record.Value)
 	case "delete":
-		if err := u.Delete(record.Value); err != nil {
-			log.Println(err)
-			return err
-		}
+		res, err = u.Delete(record.Value)
 	case "logout":
-		if err := u.LogOut(record.Value); err != nil {
+		res, err = u.LogOut(record.Value)
+	default:
+		return nil
+	}
+	// Only successful results are kept, a failed command may be retried
+	if key != "" && err == nil {
+		processed := &models.ProcessedKey{
+			Key:       key,
+			Kind:      string(record.Key),
+			CommandID: header(record, CommandHeader),
+			EntityID:  res.Id,
+			Message:   res.Message,
+		}
+		if _, err := u.S.SaveProcessedKey(u.Ctx, processed); err != nil {
 			log.Println(err)
-			return err
 		}
 	}
-	return nil
+	u.record(record, res, err)
+	return err
+}
+
+func header(record *kgo.Record, key string) string {
+	for _, h := range record.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// record saves the result of a command under the id from its header.
+// Records produced before command ids existed carry no header and are skipped.
+func (u *Consumer17) record(record *kgo.Record, res *user.GeneralResponse, err error) {
+	id := header(record, CommandHeader)
+	if id == "" {
+		return
+	}
+
+	owner, _ := strconv.Atoi(header(record, UserHeader))
+	command := models.Command{ID: id, Kind: string(record.Key), UserID: int32(owner), Status: models.CommandSucceeded}
+	if res != nil {
+		command.EntityID = res.Id
+		command.Message = res.Message
+	}
+	// A signup has no user yet, its command belongs to the user it creates
+	if command.UserID == 0 && command.Kind == "create" {
+		command.UserID = command.EntityID
+	}
+	if err != nil {
+		command.Status = models.CommandFailed
+		command.Error = err.Error()
+	}
+	if _, err := u.S.SaveCommand(u.Ctx, &command); err != nil {
+		log.Println(err)
+	}
 }
 
-func (u *Consumer17) Create(req []byte) error {
+func (u *Consumer17) Create(req []byte) (*user.GeneralResponse, error) {
 	var req1 models.RegisterUserRequest
 
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	var newreq = user.RegisterUserRequest{
-		Username: req1.Username,
-		Age:      req1.Age,
-		Email:    req1.Email,
-		Password: req1.Password,
+		Username:     req1.Username,
+		Age:          req1.Age,
+		Email:        req1.Email,
+		Password:     req1.Password,
+		PasswordHash: req1.PasswordHash,
 	}
-	_, err := u.C.Register(u.Ctx, &newreq)
+	res, err := u.C.Register(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
 
-func (u *Consumer17) Update(req []byte) error {
+func (u *Consumer17) Update(req []byte) (*user.GeneralResponse, error) {
 	var req1 models.UpdateUserRequest
 
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	var newreq = user.UpdateUserRequest{
 		Id:       req1.ID,
@@ -102,48 +185,48 @@ func (u *Consumer17) Update(req []byte) error {
 		Email:    req1.Email,
 		Password: req1.Password,
 	}
-	_, err := u.C.UpdateUser(u.Ctx, &newreq)
+	res, err := u.C.UpdateUser(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
 
-func (u *Consumer17) LogOut(req []byte) error {
+func (u *Consumer17) LogOut(req []byte) (*user.GeneralResponse, error) {
 	var req1 models.GetUserRequest
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
 	var newreq = user.GetUserRequest{
 		Id: req1.ID,
 	}
 
-	_, err := u.C.LogOut(u.Ctx, &newreq)
+	res, err := u.C.LogOut(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
 
-func (u *Consumer17) Delete(req []byte) error {
+func (u *Consumer17) Delete(req []byte) (*user.GeneralResponse, error) {
 	var req1 models.GetUserRequest
 	if err := json.Unmarshal(req, &req1); err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
 	var newreq = user.GetUserRequest{
 		Id: req1.ID,
 	}
 
-	_, err := u.C.DeleteUser(u.Ctx, &newreq)
+	res, err := u.C.DeleteUser(u.Ctx, &newreq)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
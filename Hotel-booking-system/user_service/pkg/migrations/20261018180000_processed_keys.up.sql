@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS processed_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    kind VARCHAR NOT NULL,
+    command_id VARCHAR(36) NOT NULL DEFAULT '',
+    entity_id INT NOT NULL DEFAULT 0,
+    message VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
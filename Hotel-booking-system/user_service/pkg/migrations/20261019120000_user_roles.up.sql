@@ -0,0 +1,3 @@
+-- Roles go into the login token; the gateway lets admins act on any user's
+-- records and everyone else only on their own.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{user}';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS hotel_managers;
+
+ALTER TABLE users ALTER COLUMN roles SET DEFAULT '{user}';
+UPDATE users SET roles = array_replace(roles, 'guest', 'user');
@@ -0,0 +1,13 @@
+-- Roles are now guest, manager and admin; "user" was the guest role. Only
+-- admins grant roles, so the first one is made by hand:
+--   UPDATE users SET roles = array_append(roles, 'admin') WHERE email = '...';
+UPDATE users SET roles = array_replace(roles, 'user', 'guest');
+ALTER TABLE users ALTER COLUMN roles SET DEFAULT '{guest}';
+
+-- The hotels a manager may run. The hotel service owns the hotels, so there
+-- is no foreign key on hotel_id.
+CREATE TABLE IF NOT EXISTS hotel_managers (
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    hotel_id INT NOT NULL,
+    PRIMARY KEY (user_id, hotel_id)
+);
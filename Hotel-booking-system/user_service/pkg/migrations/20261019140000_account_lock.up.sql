@@ -0,0 +1,3 @@
+-- Accounts are locked for a while after repeated failed logins, until
+-- locked_until passes or an admin unlocks them.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;